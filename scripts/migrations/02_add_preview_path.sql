@@ -0,0 +1,2 @@
+-- Looping preview generated by the processing worker
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS preview_path TEXT;
@@ -0,0 +1,3 @@
+-- Audio stream presence and the optional extracted audio artifact
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS has_audio BOOLEAN;
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS audio_path TEXT;
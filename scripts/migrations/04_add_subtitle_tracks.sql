@@ -0,0 +1,16 @@
+-- Text subtitle streams extracted from the source video
+CREATE TABLE IF NOT EXISTS videos.subtitle_tracks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    video_id UUID NOT NULL REFERENCES videos.videos(id) ON DELETE CASCADE,
+    stream_index INTEGER NOT NULL,
+    language VARCHAR(16) NOT NULL,
+    codec VARCHAR(32) NOT NULL,
+    srt_path TEXT NOT NULL,
+    vtt_path TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (video_id, stream_index)
+);
+
+CREATE INDEX IF NOT EXISTS idx_subtitle_tracks_video_id ON videos.subtitle_tracks(video_id);
+
+GRANT ALL PRIVILEGES ON videos.subtitle_tracks TO videoadmin;
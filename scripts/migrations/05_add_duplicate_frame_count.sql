@@ -0,0 +1,2 @@
+-- Frames dropped by the optional perceptual-hash dedupe stage
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS duplicate_frame_count INTEGER;
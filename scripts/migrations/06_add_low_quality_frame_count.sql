@@ -0,0 +1,2 @@
+-- Frames dropped by the optional black/blank/blurry quality filter
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS low_quality_frame_count INTEGER;
@@ -0,0 +1,18 @@
+-- Shot boundaries detected from the ffmpeg scene change score
+CREATE TABLE IF NOT EXISTS videos.shots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    video_id UUID NOT NULL REFERENCES videos.videos(id) ON DELETE CASCADE,
+    shot_index INTEGER NOT NULL,
+    start_time DOUBLE PRECISION NOT NULL,
+    end_time DOUBLE PRECISION NOT NULL,
+    frame_path TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (video_id, shot_index)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shots_video_id ON videos.shots(video_id);
+
+GRANT ALL PRIVILEGES ON videos.shots TO videoadmin;
+
+-- Source frame rate, used to express shot boundaries as timecodes
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS frame_rate DOUBLE PRECISION;
@@ -0,0 +1,6 @@
+-- Activity timeline: one byte per second holding the percentage of the
+-- picture that changed since the previous second
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS activity_scores BYTEA;
+
+-- Frames dropped by the optional keep-active-only filter
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS inactive_frame_count INTEGER;
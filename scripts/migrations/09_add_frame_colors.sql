@@ -0,0 +1,18 @@
+-- Dominant colors of each uploaded frame, searched by RGB distance
+CREATE TABLE IF NOT EXISTS videos.frame_colors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    video_id UUID NOT NULL REFERENCES videos.videos(id) ON DELETE CASCADE,
+    frame_index INTEGER NOT NULL,
+    rank INTEGER NOT NULL,
+    hex VARCHAR(7) NOT NULL,
+    r SMALLINT NOT NULL,
+    g SMALLINT NOT NULL,
+    b SMALLINT NOT NULL,
+    weight DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (video_id, frame_index, rank)
+);
+
+CREATE INDEX IF NOT EXISTS idx_frame_colors_video_id ON videos.frame_colors(video_id);
+
+GRANT ALL PRIVILEGES ON videos.frame_colors TO videoadmin;
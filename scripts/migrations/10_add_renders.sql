@@ -0,0 +1,21 @@
+-- Videos assembled from the extracted frames of a source video
+CREATE TABLE IF NOT EXISTS videos.renders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    video_id UUID NOT NULL REFERENCES videos.videos(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    format VARCHAR(8) NOT NULL,
+    fps DOUBLE PRECISION NOT NULL,
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL,
+    frame_count INTEGER,
+    output_path TEXT,
+    error_message TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_renders_video_id ON videos.renders(video_id);
+
+GRANT ALL PRIVILEGES ON videos.renders TO videoadmin;
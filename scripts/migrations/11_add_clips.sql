@@ -0,0 +1,21 @@
+-- Sub-ranges cut from the source video, stream copied or re-encoded
+CREATE TABLE IF NOT EXISTS videos.clips (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    video_id UUID NOT NULL REFERENCES videos.videos(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    clip_index INTEGER NOT NULL,
+    start_time DOUBLE PRECISION NOT NULL,
+    end_time DOUBLE PRECISION NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    mode VARCHAR(16),
+    output_path TEXT,
+    size_bytes BIGINT,
+    error_message TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_clips_video_id ON videos.clips(video_id);
+
+GRANT ALL PRIVILEGES ON videos.clips TO videoadmin;
@@ -0,0 +1,2 @@
+-- Master playlist of the optional HLS streaming ladder
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS hls_path TEXT;
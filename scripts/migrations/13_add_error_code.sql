@@ -0,0 +1,2 @@
+-- Machine-readable reason a source was rejected, set alongside error_message
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS error_code VARCHAR(50);
@@ -0,0 +1,22 @@
+-- Progress of a processing job, kept until it completes so a retried job
+-- can reuse the frames and stages an earlier attempt finished
+CREATE TABLE IF NOT EXISTS videos.checkpoint_frames (
+    video_id UUID NOT NULL REFERENCES videos.videos(id) ON DELETE CASCADE,
+    frame_index INTEGER NOT NULL,
+    segment INTEGER NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    etag VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (video_id, frame_index)
+);
+
+CREATE TABLE IF NOT EXISTS videos.checkpoint_stages (
+    video_id UUID NOT NULL REFERENCES videos.videos(id) ON DELETE CASCADE,
+    stage VARCHAR(50) NOT NULL,
+    completed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (video_id, stage)
+);
+
+GRANT ALL PRIVILEGES ON videos.checkpoint_frames TO videoadmin;
+GRANT ALL PRIVILEGES ON videos.checkpoint_stages TO videoadmin;
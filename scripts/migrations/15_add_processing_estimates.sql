@@ -0,0 +1,9 @@
+-- Inputs of the completion estimate: the upload's size, its probed duration,
+-- the processing stages its options turned on and its queue priority
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS file_size BIGINT;
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS source_duration DOUBLE PRECISION;
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS options_profile VARCHAR(255);
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_videos_completed_at ON videos.videos(completed_at) WHERE status = 'COMPLETED';
+CREATE INDEX IF NOT EXISTS idx_videos_pending_queue ON videos.videos(priority, created_at) WHERE status = 'PENDING';
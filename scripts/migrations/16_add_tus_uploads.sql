@@ -0,0 +1,22 @@
+-- Resumable (tus) uploads in progress. The ID becomes the video's ID once
+-- the last byte arrives; bytes short of a full multipart part wait in tail.
+CREATE TABLE IF NOT EXISTS videos.tus_uploads (
+    id UUID PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    upload_length BIGINT NOT NULL,
+    upload_offset BIGINT NOT NULL DEFAULT 0,
+    s3_key TEXT NOT NULL,
+    multipart_id TEXT NOT NULL,
+    part_count INTEGER NOT NULL DEFAULT 0,
+    tail BYTEA,
+    options TEXT,
+    video_id UUID,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_tus_uploads_expires_at ON videos.tus_uploads(expires_at);
+
+GRANT ALL PRIVILEGES ON videos.tus_uploads TO videoadmin;
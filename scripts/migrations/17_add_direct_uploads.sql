@@ -0,0 +1,5 @@
+-- Videos the client uploads straight to S3 stay PENDING but out of the queue
+-- until the upload is confirmed; the options wait with them
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS awaiting_upload BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS multipart_upload_id TEXT;
+ALTER TABLE videos.videos ADD COLUMN IF NOT EXISTS processing_options TEXT;
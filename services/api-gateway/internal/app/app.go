@@ -15,13 +15,24 @@ import (
 	apiController "github.com/video-platform/services/api-gateway/internal/infrastructure/api/controller"
 	"github.com/video-platform/services/api-gateway/internal/infrastructure/persistence"
 	"github.com/video-platform/services/api-gateway/internal/presenter"
+	"github.com/video-platform/services/api-gateway/internal/usecase/activity"
+	"github.com/video-platform/services/api-gateway/internal/usecase/clips"
+	"github.com/video-platform/services/api-gateway/internal/usecase/contactsheets"
 	"github.com/video-platform/services/api-gateway/internal/usecase/download"
+	"github.com/video-platform/services/api-gateway/internal/usecase/frames"
 	"github.com/video-platform/services/api-gateway/internal/usecase/list"
+	"github.com/video-platform/services/api-gateway/internal/usecase/playback"
+	"github.com/video-platform/services/api-gateway/internal/usecase/render"
+	"github.com/video-platform/services/api-gateway/internal/usecase/shots"
 	"github.com/video-platform/services/api-gateway/internal/usecase/status"
+	"github.com/video-platform/services/api-gateway/internal/usecase/thumbnails"
 	"github.com/video-platform/services/api-gateway/internal/usecase/upload"
+	"github.com/video-platform/services/api-gateway/internal/usecase/watermark"
+	"github.com/video-platform/services/api-gateway/internal/usecase/workers"
 	"github.com/video-platform/shared/pkg/auth/jwt"
 	"github.com/video-platform/shared/pkg/config"
 	"github.com/video-platform/shared/pkg/database/postgres"
+	"github.com/video-platform/shared/pkg/database/redis"
 	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
 	"github.com/video-platform/shared/pkg/storage/s3"
 )
@@ -36,6 +47,10 @@ func InitializeApp() *fx.App {
 				return jwt.NewJWTManager(cfg.JWTSecret, cfg.JWTAccessExpiry, cfg.JWTRefreshExpiry)
 			},
 
+			func(cfg *config.Config) (*redis.Client, error) {
+				return redis.NewRedisClient(cfg.RedisURL)
+			},
+
 			func(cfg *config.Config) (s3.S3Client, error) {
 				return s3.NewS3Client(cfg.AWSRegion, cfg.AWSAccessKeyID, cfg.AWSSecretAccessKey, cfg.S3UploadsBucket)
 			},
@@ -45,11 +60,97 @@ func InitializeApp() *fx.App {
 			},
 
 			fx.Annotate(persistence.NewVideoRepository, fx.As(new(repositories.VideoRepository))),
-
-			fx.Annotate(upload.NewUploadUseCase, fx.As(new(upload.UploadUseCase))),
-			fx.Annotate(list.NewListUseCase, fx.As(new(list.ListUseCase))),
-			fx.Annotate(status.NewStatusUseCase, fx.As(new(status.StatusUseCase))),
+			fx.Annotate(persistence.NewSubtitleTrackRepository, fx.As(new(repositories.SubtitleTrackRepository))),
+			fx.Annotate(persistence.NewShotRepository, fx.As(new(repositories.ShotRepository))),
+			fx.Annotate(persistence.NewFrameColorRepository, fx.As(new(repositories.FrameColorRepository))),
+			fx.Annotate(persistence.NewRenderRepository, fx.As(new(repositories.RenderRepository))),
+			fx.Annotate(persistence.NewClipRepository, fx.As(new(repositories.ClipRepository))),
+			fx.Annotate(persistence.NewWorkerRepository, fx.As(new(repositories.WorkerRepository))),
+			fx.Annotate(persistence.NewTusUploadRepository, fx.As(new(repositories.TusUploadRepository))),
+
+			func(
+				videoRepo repositories.VideoRepository,
+				s3Client s3.S3Client,
+				publisher rabbitmq.Publisher,
+				cfg *config.Config,
+			) upload.UploadUseCase {
+				return upload.NewUploadUseCase(videoRepo, s3Client, publisher, upload.DispatchPolicy{
+					PremiumUserIDs: cfg.PremiumUserIDs,
+					UserJobLimit:   cfg.UserJobLimit,
+				})
+			},
+			func(
+				tusRepo repositories.TusUploadRepository,
+				videoRepo repositories.VideoRepository,
+				s3Client s3.S3Client,
+				publisher rabbitmq.Publisher,
+				cfg *config.Config,
+			) upload.WriteTusChunkUseCase {
+				return upload.NewWriteTusChunkUseCase(tusRepo, videoRepo, s3Client, publisher, upload.DispatchPolicy{
+					PremiumUserIDs: cfg.PremiumUserIDs,
+					UserJobLimit:   cfg.UserJobLimit,
+				})
+			},
+			func(
+				videoRepo repositories.VideoRepository,
+				s3Client s3.S3Client,
+				publisher rabbitmq.Publisher,
+				cfg *config.Config,
+			) upload.CompleteUploadUseCase {
+				return upload.NewCompleteUploadUseCase(videoRepo, s3Client, publisher, upload.DispatchPolicy{
+					PremiumUserIDs: cfg.PremiumUserIDs,
+					UserJobLimit:   cfg.UserJobLimit,
+				})
+			},
+			fx.Annotate(upload.NewCreateUploadURLUseCase, fx.As(new(upload.CreateUploadURLUseCase))),
+			fx.Annotate(upload.NewCreateTusUploadUseCase, fx.As(new(upload.CreateTusUploadUseCase))),
+			fx.Annotate(upload.NewTusUploadOffsetUseCase, fx.As(new(upload.TusUploadOffsetUseCase))),
+			fx.Annotate(upload.NewTerminateTusUploadUseCase, fx.As(new(upload.TerminateTusUploadUseCase))),
 			fx.Annotate(download.NewDownloadUseCase, fx.As(new(download.DownloadUseCase))),
+			fx.Annotate(activity.NewActivityUseCase, fx.As(new(activity.ActivityUseCase))),
+			fx.Annotate(render.NewRenderUseCase, fx.As(new(render.RenderUseCase))),
+			fx.Annotate(clips.NewCreateClipsUseCase, fx.As(new(clips.CreateClipsUseCase))),
+			fx.Annotate(watermark.NewUploadWatermarkUseCase, fx.As(new(watermark.UploadWatermarkUseCase))),
+
+			func(videoRepo repositories.VideoRepository, s3Client s3.S3Client, cfg *config.Config) list.ListUseCase {
+				return list.NewListUseCase(videoRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(videoRepo repositories.VideoRepository, subtitleRepo repositories.SubtitleTrackRepository, workerRepo repositories.WorkerRepository, s3Client s3.S3Client, cfg *config.Config) status.StatusUseCase {
+				return status.NewStatusUseCase(videoRepo, subtitleRepo, workerRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(videoRepo repositories.VideoRepository, s3Client s3.S3Client, cfg *config.Config) contactsheets.ContactSheetsUseCase {
+				return contactsheets.NewContactSheetsUseCase(videoRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(videoRepo repositories.VideoRepository, s3Client s3.S3Client, cfg *config.Config) thumbnails.ThumbnailsUseCase {
+				return thumbnails.NewThumbnailsUseCase(videoRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(videoRepo repositories.VideoRepository, shotRepo repositories.ShotRepository, s3Client s3.S3Client, cfg *config.Config) shots.ShotsUseCase {
+				return shots.NewShotsUseCase(videoRepo, shotRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(videoRepo repositories.VideoRepository, colorRepo repositories.FrameColorRepository, s3Client s3.S3Client, cfg *config.Config) frames.FramesUseCase {
+				return frames.NewFramesUseCase(videoRepo, colorRepo, s3Client, cfg.S3ProcessedBucket, cfg.StoragePublicURL, cfg.TransformSigningKey)
+			},
+			func(videoRepo repositories.VideoRepository, s3Client s3.S3Client, cfg *config.Config) frames.FrameUseCase {
+				return frames.NewFrameUseCase(videoRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(videoRepo repositories.VideoRepository, s3Client s3.S3Client, cfg *config.Config) frames.FrameTransformUseCase {
+				return frames.NewFrameTransformUseCase(videoRepo, s3Client, cfg.S3ProcessedBucket, cfg.StoragePublicURL, cfg.TransformSigningKey)
+			},
+			func(videoRepo repositories.VideoRepository, renderRepo repositories.RenderRepository, s3Client s3.S3Client, cfg *config.Config) render.RenderStatusUseCase {
+				return render.NewRenderStatusUseCase(videoRepo, renderRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(videoRepo repositories.VideoRepository, clipRepo repositories.ClipRepository, s3Client s3.S3Client, cfg *config.Config) clips.ClipsUseCase {
+				return clips.NewClipsUseCase(videoRepo, clipRepo, s3Client, cfg.S3ProcessedBucket)
+			},
+			func(workerRepo repositories.WorkerRepository, cfg *config.Config) workers.WorkersUseCase {
+				return workers.NewWorkersUseCase(workerRepo, cfg.AdminUserIDs)
+			},
+			func(videoRepo repositories.VideoRepository, cfg *config.Config) playback.PlaybackUseCase {
+				return playback.NewPlaybackUseCase(videoRepo, cfg.APIPublicURL, playbackSigningKey(cfg))
+			},
+			func(videoRepo repositories.VideoRepository, s3Client s3.S3Client, cfg *config.Config) playback.PlaylistUseCase {
+				return playback.NewPlaylistUseCase(videoRepo, s3Client, cfg.S3ProcessedBucket, playbackSigningKey(cfg))
+			},
 
 			fx.Annotate(controller.NewVideoController, fx.As(new(controller.VideoController))),
 			fx.Annotate(presenter.NewVideoPresenter, fx.As(new(presenter.VideoPresenter))),
@@ -77,13 +178,25 @@ func registerRoutes(r *chi.Mux, httpController *apiController.VideoHTTPControlle
 	})
 }
 
+// playbackSigningKey falls back to the JWT secret so playback works without
+// extra configuration; both stay inside the gateway.
+func playbackSigningKey(cfg *config.Config) string {
+	if cfg.PlaybackSigningKey != "" {
+		return cfg.PlaybackSigningKey
+	}
+	return cfg.JWTSecret
+}
+
 func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Tus-Resumable, Upload-Length, Upload-Metadata, Upload-Offset")
+		w.Header().Set("Access-Control-Expose-Headers", "Location, Tus-Resumable, Tus-Version, Tus-Extension, Tus-Max-Size, Upload-Offset, Upload-Length, Upload-Expires")
 
-		if r.Method == "OPTIONS" {
+		// Only browser preflights stop here; tus clients send plain OPTIONS
+		// requests for protocol discovery.
+		if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
 			w.WriteHeader(http.StatusOK)
 			return
 		}
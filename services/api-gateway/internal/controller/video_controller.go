@@ -3,16 +3,47 @@ package controller
 import (
 	"context"
 
+	"github.com/video-platform/services/api-gateway/internal/usecase/activity"
+	"github.com/video-platform/services/api-gateway/internal/usecase/clips"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/services/api-gateway/internal/usecase/contactsheets"
 	"github.com/video-platform/services/api-gateway/internal/usecase/download"
+	"github.com/video-platform/services/api-gateway/internal/usecase/frames"
 	"github.com/video-platform/services/api-gateway/internal/usecase/list"
+	"github.com/video-platform/services/api-gateway/internal/usecase/playback"
+	"github.com/video-platform/services/api-gateway/internal/usecase/render"
+	"github.com/video-platform/services/api-gateway/internal/usecase/shots"
 	"github.com/video-platform/services/api-gateway/internal/usecase/status"
+	"github.com/video-platform/services/api-gateway/internal/usecase/thumbnails"
 	"github.com/video-platform/services/api-gateway/internal/usecase/upload"
+	"github.com/video-platform/services/api-gateway/internal/usecase/watermark"
+	"github.com/video-platform/services/api-gateway/internal/usecase/workers"
 )
 
 type VideoController interface {
 	Upload(ctx context.Context, cmd commands.UploadCommand) (*upload.UploadOutput, error)
+	CreateUploadURL(ctx context.Context, cmd commands.CreateUploadURLCommand) (*upload.UploadURLOutput, error)
+	CompleteUpload(ctx context.Context, cmd commands.CompleteUploadCommand) (*upload.UploadOutput, error)
 	List(ctx context.Context, cmd commands.ListCommand) (*list.ListOutput, error)
 	Status(ctx context.Context, cmd commands.StatusCommand) (*status.StatusOutput, error)
 	Download(ctx context.Context, cmd commands.DownloadCommand) (*download.DownloadOutput, error)
+	ContactSheets(ctx context.Context, cmd commands.ContactSheetsCommand) (*contactsheets.ContactSheetsOutput, error)
+	Thumbnails(ctx context.Context, cmd commands.ThumbnailsCommand) (*thumbnails.ThumbnailsOutput, error)
+	Shots(ctx context.Context, cmd commands.ShotsCommand) (*shots.ShotsOutput, error)
+	Activity(ctx context.Context, cmd commands.ActivityCommand) (*activity.ActivityOutput, error)
+	Frames(ctx context.Context, cmd commands.FramesCommand) (*frames.FramesOutput, error)
+	Frame(ctx context.Context, cmd commands.FrameCommand) (*frames.FrameOutput, error)
+	FrameTransform(ctx context.Context, cmd commands.FrameTransformCommand) (*frames.FrameTransformOutput, error)
+	Render(ctx context.Context, cmd commands.RenderCommand) (*render.RenderOutput, error)
+	RenderStatus(ctx context.Context, cmd commands.RenderStatusCommand) (*render.RenderOutput, error)
+	CreateClips(ctx context.Context, cmd commands.CreateClipsCommand) (*clips.ClipsOutput, error)
+	Clips(ctx context.Context, cmd commands.ClipsCommand) (*clips.ClipsOutput, error)
+	Playback(ctx context.Context, cmd commands.PlaybackCommand) (*playback.PlaybackOutput, error)
+	Playlist(ctx context.Context, cmd commands.PlaylistCommand) (*playback.PlaylistOutput, error)
+	UploadWatermark(ctx context.Context, cmd commands.UploadWatermarkCommand) (*watermark.WatermarkOutput, error)
+	Workers(ctx context.Context, cmd commands.WorkersCommand) (*workers.WorkersOutput, error)
+	CreateTusUpload(ctx context.Context, cmd commands.CreateTusUploadCommand) (*upload.TusUploadOutput, error)
+	TusUploadOffset(ctx context.Context, cmd commands.TusUploadOffsetCommand) (*upload.TusUploadOutput, error)
+	WriteTusChunk(ctx context.Context, cmd commands.WriteTusChunkCommand) (*upload.TusUploadOutput, error)
+	TerminateTusUpload(ctx context.Context, cmd commands.TerminateTusUploadCommand) error
 }
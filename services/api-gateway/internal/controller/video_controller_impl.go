@@ -3,31 +3,104 @@ package controller
 import (
 	"context"
 
+	"github.com/video-platform/services/api-gateway/internal/usecase/activity"
+	"github.com/video-platform/services/api-gateway/internal/usecase/clips"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/services/api-gateway/internal/usecase/contactsheets"
 	"github.com/video-platform/services/api-gateway/internal/usecase/download"
+	"github.com/video-platform/services/api-gateway/internal/usecase/frames"
 	"github.com/video-platform/services/api-gateway/internal/usecase/list"
+	"github.com/video-platform/services/api-gateway/internal/usecase/playback"
+	"github.com/video-platform/services/api-gateway/internal/usecase/render"
+	"github.com/video-platform/services/api-gateway/internal/usecase/shots"
 	"github.com/video-platform/services/api-gateway/internal/usecase/status"
+	"github.com/video-platform/services/api-gateway/internal/usecase/thumbnails"
 	"github.com/video-platform/services/api-gateway/internal/usecase/upload"
+	"github.com/video-platform/services/api-gateway/internal/usecase/watermark"
+	"github.com/video-platform/services/api-gateway/internal/usecase/workers"
 )
 
 type videoControllerImpl struct {
-	uploadUseCase   upload.UploadUseCase
-	listUseCase     list.ListUseCase
-	statusUseCase   status.StatusUseCase
-	downloadUseCase download.DownloadUseCase
+	uploadUseCase         upload.UploadUseCase
+	uploadURLUseCase      upload.CreateUploadURLUseCase
+	completeUseCase       upload.CompleteUploadUseCase
+	listUseCase           list.ListUseCase
+	statusUseCase         status.StatusUseCase
+	downloadUseCase       download.DownloadUseCase
+	contactSheetsUseCase  contactsheets.ContactSheetsUseCase
+	thumbnailsUseCase     thumbnails.ThumbnailsUseCase
+	shotsUseCase          shots.ShotsUseCase
+	activityUseCase       activity.ActivityUseCase
+	framesUseCase         frames.FramesUseCase
+	frameUseCase          frames.FrameUseCase
+	frameTransformUseCase frames.FrameTransformUseCase
+	renderUseCase         render.RenderUseCase
+	renderStatusUseCase   render.RenderStatusUseCase
+	createClipsUseCase    clips.CreateClipsUseCase
+	clipsUseCase          clips.ClipsUseCase
+	playbackUseCase       playback.PlaybackUseCase
+	playlistUseCase       playback.PlaylistUseCase
+	watermarkUseCase      watermark.UploadWatermarkUseCase
+	workersUseCase        workers.WorkersUseCase
+	createTusUseCase      upload.CreateTusUploadUseCase
+	tusOffsetUseCase      upload.TusUploadOffsetUseCase
+	tusChunkUseCase       upload.WriteTusChunkUseCase
+	terminateTusUseCase   upload.TerminateTusUploadUseCase
 }
 
 func NewVideoController(
 	uploadUseCase upload.UploadUseCase,
+	uploadURLUseCase upload.CreateUploadURLUseCase,
+	completeUseCase upload.CompleteUploadUseCase,
 	listUseCase list.ListUseCase,
 	statusUseCase status.StatusUseCase,
 	downloadUseCase download.DownloadUseCase,
+	contactSheetsUseCase contactsheets.ContactSheetsUseCase,
+	thumbnailsUseCase thumbnails.ThumbnailsUseCase,
+	shotsUseCase shots.ShotsUseCase,
+	activityUseCase activity.ActivityUseCase,
+	framesUseCase frames.FramesUseCase,
+	frameUseCase frames.FrameUseCase,
+	frameTransformUseCase frames.FrameTransformUseCase,
+	renderUseCase render.RenderUseCase,
+	renderStatusUseCase render.RenderStatusUseCase,
+	createClipsUseCase clips.CreateClipsUseCase,
+	clipsUseCase clips.ClipsUseCase,
+	playbackUseCase playback.PlaybackUseCase,
+	playlistUseCase playback.PlaylistUseCase,
+	watermarkUseCase watermark.UploadWatermarkUseCase,
+	workersUseCase workers.WorkersUseCase,
+	createTusUseCase upload.CreateTusUploadUseCase,
+	tusOffsetUseCase upload.TusUploadOffsetUseCase,
+	tusChunkUseCase upload.WriteTusChunkUseCase,
+	terminateTusUseCase upload.TerminateTusUploadUseCase,
 ) VideoController {
 	return &videoControllerImpl{
-		uploadUseCase:   uploadUseCase,
-		listUseCase:     listUseCase,
-		statusUseCase:   statusUseCase,
-		downloadUseCase: downloadUseCase,
+		uploadUseCase:         uploadUseCase,
+		uploadURLUseCase:      uploadURLUseCase,
+		completeUseCase:       completeUseCase,
+		listUseCase:           listUseCase,
+		statusUseCase:         statusUseCase,
+		downloadUseCase:       downloadUseCase,
+		contactSheetsUseCase:  contactSheetsUseCase,
+		thumbnailsUseCase:     thumbnailsUseCase,
+		shotsUseCase:          shotsUseCase,
+		activityUseCase:       activityUseCase,
+		framesUseCase:         framesUseCase,
+		frameUseCase:          frameUseCase,
+		frameTransformUseCase: frameTransformUseCase,
+		renderUseCase:         renderUseCase,
+		renderStatusUseCase:   renderStatusUseCase,
+		createClipsUseCase:    createClipsUseCase,
+		clipsUseCase:          clipsUseCase,
+		playbackUseCase:       playbackUseCase,
+		playlistUseCase:       playlistUseCase,
+		watermarkUseCase:      watermarkUseCase,
+		workersUseCase:        workersUseCase,
+		createTusUseCase:      createTusUseCase,
+		tusOffsetUseCase:      tusOffsetUseCase,
+		tusChunkUseCase:       tusChunkUseCase,
+		terminateTusUseCase:   terminateTusUseCase,
 	}
 }
 
@@ -35,6 +108,14 @@ func (c *videoControllerImpl) Upload(ctx context.Context, cmd commands.UploadCom
 	return c.uploadUseCase.Execute(ctx, cmd)
 }
 
+func (c *videoControllerImpl) CreateUploadURL(ctx context.Context, cmd commands.CreateUploadURLCommand) (*upload.UploadURLOutput, error) {
+	return c.uploadURLUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) CompleteUpload(ctx context.Context, cmd commands.CompleteUploadCommand) (*upload.UploadOutput, error) {
+	return c.completeUseCase.Execute(ctx, cmd)
+}
+
 func (c *videoControllerImpl) List(ctx context.Context, cmd commands.ListCommand) (*list.ListOutput, error) {
 	return c.listUseCase.Execute(ctx, cmd)
 }
@@ -46,3 +127,79 @@ func (c *videoControllerImpl) Status(ctx context.Context, cmd commands.StatusCom
 func (c *videoControllerImpl) Download(ctx context.Context, cmd commands.DownloadCommand) (*download.DownloadOutput, error) {
 	return c.downloadUseCase.Execute(ctx, cmd)
 }
+
+func (c *videoControllerImpl) ContactSheets(ctx context.Context, cmd commands.ContactSheetsCommand) (*contactsheets.ContactSheetsOutput, error) {
+	return c.contactSheetsUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Thumbnails(ctx context.Context, cmd commands.ThumbnailsCommand) (*thumbnails.ThumbnailsOutput, error) {
+	return c.thumbnailsUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Shots(ctx context.Context, cmd commands.ShotsCommand) (*shots.ShotsOutput, error) {
+	return c.shotsUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Activity(ctx context.Context, cmd commands.ActivityCommand) (*activity.ActivityOutput, error) {
+	return c.activityUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Frames(ctx context.Context, cmd commands.FramesCommand) (*frames.FramesOutput, error) {
+	return c.framesUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Frame(ctx context.Context, cmd commands.FrameCommand) (*frames.FrameOutput, error) {
+	return c.frameUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) FrameTransform(ctx context.Context, cmd commands.FrameTransformCommand) (*frames.FrameTransformOutput, error) {
+	return c.frameTransformUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Render(ctx context.Context, cmd commands.RenderCommand) (*render.RenderOutput, error) {
+	return c.renderUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) RenderStatus(ctx context.Context, cmd commands.RenderStatusCommand) (*render.RenderOutput, error) {
+	return c.renderStatusUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) CreateClips(ctx context.Context, cmd commands.CreateClipsCommand) (*clips.ClipsOutput, error) {
+	return c.createClipsUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Clips(ctx context.Context, cmd commands.ClipsCommand) (*clips.ClipsOutput, error) {
+	return c.clipsUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Playback(ctx context.Context, cmd commands.PlaybackCommand) (*playback.PlaybackOutput, error) {
+	return c.playbackUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Playlist(ctx context.Context, cmd commands.PlaylistCommand) (*playback.PlaylistOutput, error) {
+	return c.playlistUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) UploadWatermark(ctx context.Context, cmd commands.UploadWatermarkCommand) (*watermark.WatermarkOutput, error) {
+	return c.watermarkUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) Workers(ctx context.Context, cmd commands.WorkersCommand) (*workers.WorkersOutput, error) {
+	return c.workersUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) CreateTusUpload(ctx context.Context, cmd commands.CreateTusUploadCommand) (*upload.TusUploadOutput, error) {
+	return c.createTusUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) TusUploadOffset(ctx context.Context, cmd commands.TusUploadOffsetCommand) (*upload.TusUploadOutput, error) {
+	return c.tusOffsetUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) WriteTusChunk(ctx context.Context, cmd commands.WriteTusChunkCommand) (*upload.TusUploadOutput, error) {
+	return c.tusChunkUseCase.Execute(ctx, cmd)
+}
+
+func (c *videoControllerImpl) TerminateTusUpload(ctx context.Context, cmd commands.TerminateTusUploadCommand) error {
+	return c.terminateTusUseCase.Execute(ctx, cmd)
+}
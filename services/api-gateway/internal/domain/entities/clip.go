@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Clip is a sub-range of the source video, in seconds from its start. Mode
+// is COPY when the clip was cut without re-encoding, REENCODE otherwise.
+type Clip struct {
+	ID           uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	VideoID      uuid.UUID   `gorm:"type:uuid;not null;index"`
+	UserID       int64       `gorm:"not null"`
+	Index        int         `gorm:"column:clip_index;not null"`
+	StartTime    float64     `gorm:"not null"`
+	EndTime      float64     `gorm:"not null"`
+	Status       VideoStatus `gorm:"type:varchar(20);not null"`
+	Mode         *string     `gorm:"type:varchar(16)"`
+	OutputPath   *string     `gorm:"type:text"`
+	SizeBytes    *int64      `gorm:"type:bigint"`
+	ErrorMessage *string     `gorm:"type:text"`
+	CreatedAt    time.Time   `gorm:"autoCreateTime"`
+	StartedAt    *time.Time  `gorm:"type:timestamp"`
+	CompletedAt  *time.Time  `gorm:"type:timestamp"`
+}
+
+func (Clip) TableName() string {
+	return "videos.clips"
+}
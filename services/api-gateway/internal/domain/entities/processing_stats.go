@@ -0,0 +1,13 @@
+package entities
+
+// ProcessingStats sums the processing time of recently completed videos.
+// SizedSeconds and TimedSeconds only cover the videos whose upload size or
+// source duration is known, so they pair with Bytes and SourceSeconds.
+type ProcessingStats struct {
+	Count         int64
+	Seconds       float64
+	SizedSeconds  float64
+	Bytes         int64
+	TimedSeconds  float64
+	SourceSeconds float64
+}
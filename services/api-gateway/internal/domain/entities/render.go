@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Render is a video assembled from the extracted frames of a source video.
+// It goes through the same statuses as a video; Width and Height of zero
+// keep the frame size.
+type Render struct {
+	ID           uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	VideoID      uuid.UUID   `gorm:"type:uuid;not null;index"`
+	UserID       int64       `gorm:"not null"`
+	Format       string      `gorm:"type:varchar(8);not null"`
+	FPS          float64     `gorm:"not null"`
+	Width        int         `gorm:"not null;default:0"`
+	Height       int         `gorm:"not null;default:0"`
+	Status       VideoStatus `gorm:"type:varchar(20);not null"`
+	FrameCount   *int        `gorm:"type:int"`
+	OutputPath   *string     `gorm:"type:text"`
+	ErrorMessage *string     `gorm:"type:text"`
+	CreatedAt    time.Time   `gorm:"autoCreateTime"`
+	StartedAt    *time.Time  `gorm:"type:timestamp"`
+	CompletedAt  *time.Time  `gorm:"type:timestamp"`
+}
+
+func (Render) TableName() string {
+	return "videos.renders"
+}
@@ -0,0 +1,21 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Shot struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	VideoID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	Index     int       `gorm:"column:shot_index;not null"`
+	StartTime float64   `gorm:"not null"`
+	EndTime   float64   `gorm:"not null"`
+	FramePath *string   `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (Shot) TableName() string {
+	return "videos.shots"
+}
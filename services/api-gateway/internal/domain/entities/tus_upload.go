@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TusUpload is a resumable upload in progress, assembled in an S3 multipart
+// upload. Tail holds the received bytes that do not fill a part yet, so
+// Offset counts them on top of the PartCount parts already in S3. VideoID is
+// set once the last byte arrived and the video was queued.
+type TusUpload struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	UserID      int64      `gorm:"not null"`
+	Filename    string     `gorm:"type:varchar(255);not null"`
+	Length      int64      `gorm:"column:upload_length;not null"`
+	Offset      int64      `gorm:"column:upload_offset;not null;default:0"`
+	S3Key       string     `gorm:"type:text;not null"`
+	MultipartID string     `gorm:"type:text;not null"`
+	PartCount   int        `gorm:"not null;default:0"`
+	Tail        []byte     `gorm:"type:bytea"`
+	Options     string     `gorm:"type:text"`
+	VideoID     *uuid.UUID `gorm:"type:uuid"`
+	ExpiresAt   time.Time  `gorm:"type:timestamp;not null;index"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime"`
+}
+
+func (TusUpload) TableName() string {
+	return "videos.tus_uploads"
+}
@@ -15,20 +15,40 @@ const (
 	StatusFailed     VideoStatus = "FAILED"
 )
 
+// Video is an uploaded source and its processing results. A video the client
+// uploads straight to S3 is AwaitingUpload, and kept out of the queue, until
+// the upload is confirmed; ProcessingOptions holds its options until then.
 type Video struct {
-	ID           uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	UserID       int64       `gorm:"not null;index:idx_user_status"`
-	Filename     string      `gorm:"type:varchar(255);not null"`
-	OriginalPath string      `gorm:"type:text;not null"`
-	Status       VideoStatus `gorm:"type:varchar(20);not null;index:idx_user_status"`
-	FPS          int         `gorm:"default:1"`
-	FrameCount   *int        `gorm:"type:int"`
-	ZipPath      *string     `gorm:"type:text"`
-	ErrorMessage *string     `gorm:"type:text"`
-	CreatedAt    time.Time   `gorm:"autoCreateTime;index:idx_created_at"`
-	StartedAt    *time.Time  `gorm:"type:timestamp"`
-	CompletedAt  *time.Time  `gorm:"type:timestamp"`
-	ExpiresAt    time.Time   `gorm:"type:timestamp;index:idx_expires_at"`
+	ID                   uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID               int64       `gorm:"not null;index:idx_user_status"`
+	Filename             string      `gorm:"type:varchar(255);not null"`
+	OriginalPath         string      `gorm:"type:text;not null"`
+	Status               VideoStatus `gorm:"type:varchar(20);not null;index:idx_user_status"`
+	FPS                  int         `gorm:"default:1"`
+	FrameRate            *float64    `gorm:"type:double precision"`
+	FrameCount           *int        `gorm:"type:int"`
+	DuplicateFrameCount  *int        `gorm:"type:int"`
+	LowQualityFrameCount *int        `gorm:"type:int"`
+	InactiveFrameCount   *int        `gorm:"type:int"`
+	ZipPath              *string     `gorm:"type:text"`
+	PreviewPath          *string     `gorm:"type:text"`
+	HLSPath              *string     `gorm:"type:text"`
+	HasAudio             *bool       `gorm:"type:boolean"`
+	AudioPath            *string     `gorm:"type:text"`
+	ActivityScores       []byte      `gorm:"type:bytea"`
+	ErrorMessage         *string     `gorm:"type:text"`
+	ErrorCode            *string     `gorm:"type:varchar(50)"`
+	FileSize             *int64      `gorm:"type:bigint"`
+	SourceDuration       *float64    `gorm:"type:double precision"`
+	OptionsProfile       *string     `gorm:"type:varchar(255)"`
+	Priority             int         `gorm:"type:smallint;not null;default:0"`
+	AwaitingUpload       bool        `gorm:"not null;default:false"`
+	MultipartUploadID    *string     `gorm:"type:text"`
+	ProcessingOptions    *string     `gorm:"type:text"`
+	CreatedAt            time.Time   `gorm:"autoCreateTime;index:idx_created_at"`
+	StartedAt            *time.Time  `gorm:"type:timestamp"`
+	CompletedAt          *time.Time  `gorm:"type:timestamp"`
+	ExpiresAt            time.Time   `gorm:"type:timestamp;index:idx_expires_at"`
 }
 
 func (Video) TableName() string {
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// Worker is a processing-worker instance as of its last heartbeat.
+type Worker struct {
+	ID          string      `json:"id"`
+	Host        string      `json:"host"`
+	Version     string      `json:"version"`
+	Capacity    int         `json:"capacity"`
+	StartedAt   time.Time   `json:"started_at"`
+	HeartbeatAt time.Time   `json:"heartbeat_at"`
+	Jobs        []WorkerJob `json:"jobs"`
+}
+
+// WorkerJob is a job a worker is running. Type is process, render or clip.
+type WorkerJob struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	VideoID   string    `json:"video_id"`
+	UserID    int64     `json:"user_id"`
+	StartedAt time.Time `json:"started_at"`
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+type ClipRepository interface {
+	CreateBatch(ctx context.Context, clips []*entities.Clip) error
+	FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.Clip, error)
+}
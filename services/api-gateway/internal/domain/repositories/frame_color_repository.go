@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+type FrameColorRepository interface {
+	// FindByColor returns the dominant colors of the video's frames that lie
+	// within tolerance of the given color, by RGB distance.
+	FindByColor(ctx context.Context, videoID uuid.UUID, r, g, b, tolerance int) ([]*entities.FrameColor, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+type RenderRepository interface {
+	Create(ctx context.Context, render *entities.Render) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.Render, error)
+}
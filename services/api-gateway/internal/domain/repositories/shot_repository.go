@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+type ShotRepository interface {
+	FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.Shot, error)
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+type SubtitleTrackRepository interface {
+	FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.SubtitleTrack, error)
+}
@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+var (
+	ErrTusUploadNotFound = errors.New("upload not found")
+
+	// ErrTusUploadConflict means another request moved the upload on since
+	// it was read.
+	ErrTusUploadConflict = errors.New("upload was modified concurrently")
+)
+
+type TusUploadRepository interface {
+	Create(ctx context.Context, upload *entities.TusUpload) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.TusUpload, error)
+	// UpdateProgress saves the upload's offset, parts, tail and video if its
+	// stored offset is still previousOffset.
+	UpdateProgress(ctx context.Context, upload *entities.TusUpload, previousOffset int64) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -2,15 +2,25 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 )
 
+// ErrUploadConfirmed means the video's direct upload was already confirmed.
+var ErrUploadConfirmed = errors.New("video upload was already confirmed")
+
 type VideoRepository interface {
 	Create(ctx context.Context, video *entities.Video) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error)
 	FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entities.Video, error)
 	CountByUserID(ctx context.Context, userID int64) (int64, error)
+	CountActiveByUserID(ctx context.Context, userID int64) (int64, error)
+	CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error)
+	CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error)
+	FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error
+	MarkUploaded(ctx context.Context, video *entities.Video) error
+	ReopenUpload(ctx context.Context, video *entities.Video) error
 }
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+type WorkerRepository interface {
+	FindAlive(ctx context.Context) ([]*entities.Worker, error)
+}
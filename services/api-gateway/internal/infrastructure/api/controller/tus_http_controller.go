@@ -0,0 +1,265 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/services/api-gateway/internal/usecase/upload"
+	"github.com/video-platform/shared/pkg/auth/jwt"
+	"github.com/video-platform/shared/pkg/rest"
+)
+
+// tus 1.0 protocol constants; see https://tus.io/protocols/resumable-upload.
+const (
+	tusVersion       = "1.0.0"
+	tusExtensions    = "creation,termination,expiration"
+	tusChunkMimeType = "application/offset+octet-stream"
+)
+
+// TusOptions answers protocol discovery; it needs no authentication.
+func (h *VideoHTTPController) TusOptions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Tus-Version", tusVersion)
+	w.Header().Set("Tus-Extension", tusExtensions)
+	w.Header().Set("Tus-Max-Size", strconv.FormatInt(upload.MaxTusUploadSize, 10))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *VideoHTTPController) CreateTusUpload(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	if !checkTusResumable(w, r) {
+		return
+	}
+
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length <= 0 {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid Upload-Length")
+		return
+	}
+	if length > upload.MaxTusUploadSize {
+		rest.RespondError(w, http.StatusRequestEntityTooLarge, "UPLOAD_TOO_LARGE", "upload exceeds maximum allowed size")
+		return
+	}
+
+	metadata, err := parseUploadMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid Upload-Metadata")
+		return
+	}
+
+	filename := metadata["filename"]
+	if filename == "" {
+		filename = metadata["name"]
+	}
+	if filename == "" {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "missing filename metadata")
+		return
+	}
+
+	var options commands.ProcessingOptions
+	if raw := metadata["options"]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &options); err != nil {
+			rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid processing options")
+			return
+		}
+	}
+
+	cmd := commands.CreateTusUploadCommand{
+		UserID:   claims.UserID,
+		Filename: filename,
+		Length:   length,
+		Options:  options,
+	}
+
+	output, err := h.controller.CreateTusUpload(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "UPLOAD_FAILED", err.Error())
+		return
+	}
+
+	w.Header().Set("Location", strings.TrimSuffix(r.URL.Path, "/")+"/"+output.ID.String())
+	writeTusUploadHeaders(w, output)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *VideoHTTPController) TusUploadOffset(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	if !checkTusResumable(w, r) {
+		return
+	}
+
+	uploadID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		rest.RespondError(w, http.StatusNotFound, "NOT_FOUND", "upload not found")
+		return
+	}
+
+	cmd := commands.TusUploadOffsetCommand{
+		UploadID: uploadID,
+		UserID:   claims.UserID,
+	}
+
+	output, err := h.controller.TusUploadOffset(r.Context(), cmd)
+	if err != nil {
+		respondTusError(w, err)
+		return
+	}
+
+	w.Header().Set("Upload-Length", strconv.FormatInt(output.Length, 10))
+	w.Header().Set("Cache-Control", "no-store")
+	writeTusUploadHeaders(w, output)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *VideoHTTPController) WriteTusChunk(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	if !checkTusResumable(w, r) {
+		return
+	}
+
+	if r.Header.Get("Content-Type") != tusChunkMimeType {
+		rest.RespondError(w, http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", "Content-Type must be "+tusChunkMimeType)
+		return
+	}
+
+	uploadID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		rest.RespondError(w, http.StatusNotFound, "NOT_FOUND", "upload not found")
+		return
+	}
+
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid Upload-Offset")
+		return
+	}
+
+	cmd := commands.WriteTusChunkCommand{
+		UploadID: uploadID,
+		UserID:   claims.UserID,
+		Offset:   offset,
+		Body:     r.Body,
+	}
+
+	output, err := h.controller.WriteTusChunk(r.Context(), cmd)
+	if err != nil {
+		respondTusError(w, err)
+		return
+	}
+
+	writeTusUploadHeaders(w, output)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *VideoHTTPController) TerminateTusUpload(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	if !checkTusResumable(w, r) {
+		return
+	}
+
+	uploadID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		rest.RespondError(w, http.StatusNotFound, "NOT_FOUND", "upload not found")
+		return
+	}
+
+	cmd := commands.TerminateTusUploadCommand{
+		UploadID: uploadID,
+		UserID:   claims.UserID,
+	}
+
+	if err := h.controller.TerminateTusUpload(r.Context(), cmd); err != nil {
+		respondTusError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkTusResumable sets the protocol version on the response and rejects
+// requests for another version.
+func checkTusResumable(w http.ResponseWriter, r *http.Request) bool {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	if r.Header.Get("Tus-Resumable") != tusVersion {
+		w.Header().Set("Tus-Version", tusVersion)
+		rest.RespondError(w, http.StatusPreconditionFailed, "UNSUPPORTED_VERSION", "Tus-Resumable must be "+tusVersion)
+		return false
+	}
+	return true
+}
+
+// writeTusUploadHeaders reports where the upload stands. Once complete, the
+// upload's ID is also the ID of the queued video.
+func writeTusUploadHeaders(w http.ResponseWriter, output *upload.TusUploadOutput) {
+	w.Header().Set("Upload-Offset", strconv.FormatInt(output.Offset, 10))
+	if output.VideoID == nil {
+		w.Header().Set("Upload-Expires", output.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+func respondTusError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, upload.ErrUploadNotFound):
+		rest.RespondError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+	case errors.Is(err, upload.ErrUploadExpired):
+		rest.RespondError(w, http.StatusGone, "UPLOAD_EXPIRED", err.Error())
+	case errors.Is(err, upload.ErrOffsetMismatch):
+		rest.RespondError(w, http.StatusConflict, "OFFSET_MISMATCH", err.Error())
+	case errors.Is(err, upload.ErrUnrecognizedContent):
+		rest.RespondError(w, http.StatusUnsupportedMediaType, "UNSUPPORTED_CONTENT", err.Error())
+	case errors.Is(err, upload.ErrContentMismatch):
+		rest.RespondError(w, http.StatusUnsupportedMediaType, "CONTENT_MISMATCH", err.Error())
+	default:
+		rest.RespondError(w, http.StatusInternalServerError, "UPLOAD_FAILED", err.Error())
+	}
+}
+
+// parseUploadMetadata decodes the comma separated "key base64-value" pairs
+// of the Upload-Metadata header. A key may come without a value.
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, errors.New("empty metadata key")
+		}
+
+		value, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, err
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
@@ -1,6 +1,10 @@
 package controller
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -9,10 +13,50 @@ import (
 	"github.com/video-platform/services/api-gateway/internal/controller"
 	"github.com/video-platform/services/api-gateway/internal/presenter"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/services/api-gateway/internal/usecase/upload"
+	"github.com/video-platform/services/api-gateway/internal/usecase/workers"
 	"github.com/video-platform/shared/pkg/auth/jwt"
 	"github.com/video-platform/shared/pkg/rest"
+	"github.com/video-platform/shared/pkg/urlsign"
 )
 
+const (
+	// defaultActivityThreshold matches the worker's default for keeping
+	// active frames, so the segments line up with the frames that were kept.
+	defaultActivityThreshold = 1
+
+	defaultColorTolerance = 40
+
+	defaultFramesLimit = 50
+	maxFramesLimit     = 200
+)
+
+// RenderRequest selects the frames to assemble, in playback order; an empty
+// Frames list uses every frame.
+type RenderRequest struct {
+	Frames []int   `json:"frames"`
+	Format string  `json:"format"`
+	FPS    float64 `json:"fps"`
+	Width  int     `json:"width"`
+	Height int     `json:"height"`
+}
+
+// UploadURLRequest announces a file the client uploads straight to S3.
+type UploadURLRequest struct {
+	Filename string                     `json:"filename"`
+	Size     int64                      `json:"size"`
+	Options  commands.ProcessingOptions `json:"options"`
+}
+
+// ClipsRequest lists the clips to cut, in seconds from the start of the
+// video.
+type ClipsRequest struct {
+	Ranges []struct {
+		Start float64 `json:"start"`
+		End   float64 `json:"end"`
+	} `json:"ranges"`
+}
+
 type VideoHTTPController struct {
 	controller controller.VideoController
 	presenter  presenter.VideoPresenter
@@ -30,9 +74,36 @@ func NewVideoHTTPController(
 
 func (h *VideoHTTPController) RegisterRoutes(r chi.Router, jwtManager jwt.JWTManager) {
 	r.Post("/videos/upload", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Upload)).ServeHTTP)
+	r.Post("/videos/upload-url", jwt.Middleware(jwtManager)(http.HandlerFunc(h.CreateUploadURL)).ServeHTTP)
+	r.Post("/videos/{id}/complete", jwt.Middleware(jwtManager)(http.HandlerFunc(h.CompleteUpload)).ServeHTTP)
+	r.Post("/watermarks", jwt.Middleware(jwtManager)(http.HandlerFunc(h.UploadWatermark)).ServeHTTP)
 	r.Get("/videos", jwt.Middleware(jwtManager)(http.HandlerFunc(h.List)).ServeHTTP)
 	r.Get("/videos/{id}/status", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Status)).ServeHTTP)
 	r.Get("/videos/{id}/download", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Download)).ServeHTTP)
+	r.Get("/videos/{id}/contact-sheets", jwt.Middleware(jwtManager)(http.HandlerFunc(h.ContactSheets)).ServeHTTP)
+	r.Get("/videos/{id}/thumbnails.vtt", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Thumbnails)).ServeHTTP)
+	r.Get("/videos/{id}/shots", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Shots)).ServeHTTP)
+	r.Get("/videos/{id}/activity", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Activity)).ServeHTTP)
+	r.Get("/videos/{id}/frames", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Frames)).ServeHTTP)
+	r.Get("/videos/{id}/frames/{index}", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Frame)).ServeHTTP)
+	r.Get("/videos/{id}/frames/{index}/transform", jwt.Middleware(jwtManager)(http.HandlerFunc(h.FrameTransform)).ServeHTTP)
+	r.Post("/videos/{id}/renders", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Render)).ServeHTTP)
+	r.Get("/videos/{id}/renders/{renderID}", jwt.Middleware(jwtManager)(http.HandlerFunc(h.RenderStatus)).ServeHTTP)
+	r.Post("/videos/{id}/clips", jwt.Middleware(jwtManager)(http.HandlerFunc(h.CreateClips)).ServeHTTP)
+	r.Get("/videos/{id}/clips", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Clips)).ServeHTTP)
+	r.Get("/videos/{id}/playback", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Playback)).ServeHTTP)
+	// Players fetch playlists without a bearer token; the signed query
+	// authorizes them instead.
+	r.Get("/videos/{id}/hls/*", h.Playlist)
+
+	r.Get("/admin/workers", jwt.Middleware(jwtManager)(http.HandlerFunc(h.Workers)).ServeHTTP)
+
+	// Resumable uploads over the tus 1.0 protocol.
+	r.Options("/uploads", h.TusOptions)
+	r.Post("/uploads", jwt.Middleware(jwtManager)(http.HandlerFunc(h.CreateTusUpload)).ServeHTTP)
+	r.Head("/uploads/{id}", jwt.Middleware(jwtManager)(http.HandlerFunc(h.TusUploadOffset)).ServeHTTP)
+	r.Patch("/uploads/{id}", jwt.Middleware(jwtManager)(http.HandlerFunc(h.WriteTusChunk)).ServeHTTP)
+	r.Delete("/uploads/{id}", jwt.Middleware(jwtManager)(http.HandlerFunc(h.TerminateTusUpload)).ServeHTTP)
 }
 
 func (h *VideoHTTPController) Upload(w http.ResponseWriter, r *http.Request) {
@@ -54,17 +125,33 @@ func (h *VideoHTTPController) Upload(w http.ResponseWriter, r *http.Request) {
 	}
 	defer file.Close()
 
+	var options commands.ProcessingOptions
+	if raw := r.FormValue("options"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &options); err != nil {
+			rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid processing options")
+			return
+		}
+	}
+
 	cmd := commands.UploadCommand{
 		UserID:      claims.UserID,
 		Filename:    header.Filename,
 		ContentType: header.Header.Get("Content-Type"),
 		FileSize:    header.Size,
 		FileReader:  file,
+		Options:     options,
 	}
 
 	output, err := h.controller.Upload(r.Context(), cmd)
 	if err != nil {
-		rest.RespondError(w, http.StatusBadRequest, "UPLOAD_FAILED", err.Error())
+		switch {
+		case errors.Is(err, upload.ErrUnrecognizedContent):
+			rest.RespondError(w, http.StatusUnsupportedMediaType, "UNSUPPORTED_CONTENT", err.Error())
+		case errors.Is(err, upload.ErrContentMismatch):
+			rest.RespondError(w, http.StatusUnsupportedMediaType, "CONTENT_MISMATCH", err.Error())
+		default:
+			rest.RespondError(w, http.StatusBadRequest, "UPLOAD_FAILED", err.Error())
+		}
 		return
 	}
 
@@ -72,6 +159,112 @@ func (h *VideoHTTPController) Upload(w http.ResponseWriter, r *http.Request) {
 	rest.RespondCreated(w, response)
 }
 
+func (h *VideoHTTPController) CreateUploadURL(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	var req UploadURLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid request body")
+		return
+	}
+
+	cmd := commands.CreateUploadURLCommand{
+		UserID:   claims.UserID,
+		Filename: req.Filename,
+		FileSize: req.Size,
+		Options:  req.Options,
+	}
+
+	output, err := h.controller.CreateUploadURL(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "UPLOAD_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondCreated(w, h.presenter.PresentUploadURL(output))
+}
+
+func (h *VideoHTTPController) CompleteUpload(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	cmd := commands.CompleteUploadCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+	}
+
+	output, err := h.controller.CompleteUpload(r.Context(), cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, upload.ErrUploadConfirmed):
+			rest.RespondError(w, http.StatusConflict, "UPLOAD_CONFIRMED", err.Error())
+		case errors.Is(err, upload.ErrUploadExpired):
+			rest.RespondError(w, http.StatusGone, "UPLOAD_EXPIRED", err.Error())
+		case errors.Is(err, upload.ErrObjectMissing):
+			rest.RespondError(w, http.StatusConflict, "UPLOAD_INCOMPLETE", err.Error())
+		case errors.Is(err, upload.ErrSizeMismatch):
+			rest.RespondError(w, http.StatusConflict, "SIZE_MISMATCH", err.Error())
+		case errors.Is(err, upload.ErrUnrecognizedContent):
+			rest.RespondError(w, http.StatusUnsupportedMediaType, "UNSUPPORTED_CONTENT", err.Error())
+		case errors.Is(err, upload.ErrContentMismatch):
+			rest.RespondError(w, http.StatusUnsupportedMediaType, "CONTENT_MISMATCH", err.Error())
+		default:
+			rest.RespondError(w, http.StatusBadRequest, "UPLOAD_FAILED", err.Error())
+		}
+		return
+	}
+
+	rest.RespondSuccess(w, h.presenter.PresentUpload(output))
+}
+
+func (h *VideoHTTPController) UploadWatermark(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	if err := r.ParseMultipartForm(4 << 20); err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "failed to parse form")
+		return
+	}
+
+	file, header, err := r.FormFile("image")
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "missing image file")
+		return
+	}
+	defer file.Close()
+
+	cmd := commands.UploadWatermarkCommand{
+		UserID:     claims.UserID,
+		FileSize:   header.Size,
+		FileReader: file,
+	}
+
+	output, err := h.controller.UploadWatermark(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "WATERMARK_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondCreated(w, h.presenter.PresentWatermark(output))
+}
+
 func (h *VideoHTTPController) List(w http.ResponseWriter, r *http.Request) {
 	claims, ok := jwt.GetClaimsFromContext(r.Context())
 	if !ok {
@@ -162,3 +355,521 @@ func (h *VideoHTTPController) Download(w http.ResponseWriter, r *http.Request) {
 	response := h.presenter.PresentDownload(output)
 	rest.RespondSuccess(w, response)
 }
+
+func (h *VideoHTTPController) ContactSheets(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	cmd := commands.ContactSheetsCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+	}
+
+	output, err := h.controller.ContactSheets(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "CONTACT_SHEETS_FAILED", err.Error())
+		return
+	}
+
+	response := h.presenter.PresentContactSheets(output)
+	rest.RespondSuccess(w, response)
+}
+
+func (h *VideoHTTPController) Thumbnails(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	cmd := commands.ThumbnailsCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+	}
+
+	output, err := h.controller.Thumbnails(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "THUMBNAILS_FAILED", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+	w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", output.ExpiresIn))
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(output.VTT))
+}
+
+func (h *VideoHTTPController) Shots(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	cmd := commands.ShotsCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+		Format:  r.URL.Query().Get("format"),
+	}
+
+	output, err := h.controller.Shots(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "SHOTS_FAILED", err.Error())
+		return
+	}
+
+	if output.Document == "" {
+		rest.RespondSuccess(w, h.presenter.PresentShots(output))
+		return
+	}
+
+	w.Header().Set("Content-Type", output.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s_shots.%s\"", output.VideoID, cmd.Format))
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(output.Document))
+}
+
+func (h *VideoHTTPController) Activity(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	threshold := defaultActivityThreshold
+	if value := r.URL.Query().Get("threshold"); value != "" {
+		threshold, err = strconv.Atoi(value)
+		if err != nil {
+			rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid threshold")
+			return
+		}
+	}
+
+	cmd := commands.ActivityCommand{
+		VideoID:   videoID,
+		UserID:    claims.UserID,
+		Threshold: threshold,
+	}
+
+	output, err := h.controller.Activity(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "ACTIVITY_FAILED", err.Error())
+		return
+	}
+
+	response := h.presenter.PresentActivity(output)
+	rest.RespondSuccess(w, response)
+}
+
+func (h *VideoHTTPController) Frames(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	tolerance := defaultColorTolerance
+	if value := r.URL.Query().Get("tolerance"); value != "" {
+		tolerance, err = strconv.Atoi(value)
+		if err != nil {
+			rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid tolerance")
+			return
+		}
+	}
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 || limit > maxFramesLimit {
+		limit = defaultFramesLimit
+	}
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if offset < 0 {
+		offset = 0
+	}
+
+	cmd := commands.FramesCommand{
+		VideoID:   videoID,
+		UserID:    claims.UserID,
+		Color:     r.URL.Query().Get("color"),
+		Tolerance: tolerance,
+		Limit:     limit,
+		Offset:    offset,
+	}
+
+	output, err := h.controller.Frames(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "FRAMES_FAILED", err.Error())
+		return
+	}
+
+	response := h.presenter.PresentFrames(output)
+	rest.RespondSuccess(w, response)
+}
+
+func (h *VideoHTTPController) Frame(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	index, err := strconv.Atoi(chi.URLParam(r, "index"))
+	if err != nil || index < 1 {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid frame index")
+		return
+	}
+
+	cmd := commands.FrameCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+		Index:   index,
+		Proxy:   r.URL.Query().Get("proxy") == "true",
+	}
+
+	output, err := h.controller.Frame(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "FRAME_FAILED", err.Error())
+		return
+	}
+
+	if output.Image == nil {
+		rest.RespondSuccess(w, h.presenter.PresentFrame(output))
+		return
+	}
+	defer output.Image.Close()
+
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, output.Image)
+}
+
+func (h *VideoHTTPController) FrameTransform(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	index, err := strconv.Atoi(chi.URLParam(r, "index"))
+	if err != nil || index < 1 {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid frame index")
+		return
+	}
+
+	query := r.URL.Query()
+	width, err := optionalInt(query.Get("width"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid width")
+		return
+	}
+	height, err := optionalInt(query.Get("height"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid height")
+		return
+	}
+	quality, err := optionalInt(query.Get("quality"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid quality")
+		return
+	}
+
+	cmd := commands.FrameTransformCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+		Index:   index,
+		Width:   width,
+		Height:  height,
+		Fit:     query.Get("fit"),
+		Format:  query.Get("format"),
+		Quality: quality,
+	}
+
+	output, err := h.controller.FrameTransform(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "FRAME_TRANSFORM_FAILED", err.Error())
+		return
+	}
+
+	response := h.presenter.PresentFrameTransform(output)
+	rest.RespondSuccess(w, response)
+}
+
+func (h *VideoHTTPController) Render(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	var req RenderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid request body")
+		return
+	}
+
+	cmd := commands.RenderCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+		Frames:  req.Frames,
+		Format:  req.Format,
+		FPS:     req.FPS,
+		Width:   req.Width,
+		Height:  req.Height,
+	}
+
+	output, err := h.controller.Render(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "RENDER_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondCreated(w, h.presenter.PresentRender(output))
+}
+
+func (h *VideoHTTPController) RenderStatus(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	renderID, err := uuid.Parse(chi.URLParam(r, "renderID"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid render ID")
+		return
+	}
+
+	cmd := commands.RenderStatusCommand{
+		VideoID:  videoID,
+		RenderID: renderID,
+		UserID:   claims.UserID,
+	}
+
+	output, err := h.controller.RenderStatus(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "RENDER_STATUS_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondSuccess(w, h.presenter.PresentRender(output))
+}
+
+func (h *VideoHTTPController) CreateClips(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	var req ClipsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid request body")
+		return
+	}
+
+	ranges := make([]commands.ClipRange, len(req.Ranges))
+	for i, rng := range req.Ranges {
+		ranges[i] = commands.ClipRange{Start: rng.Start, End: rng.End}
+	}
+
+	cmd := commands.CreateClipsCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+		Ranges:  ranges,
+	}
+
+	output, err := h.controller.CreateClips(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "CLIPS_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondCreated(w, h.presenter.PresentClips(output))
+}
+
+func (h *VideoHTTPController) Clips(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	cmd := commands.ClipsCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+	}
+
+	output, err := h.controller.Clips(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "CLIPS_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondSuccess(w, h.presenter.PresentClips(output))
+}
+
+func (h *VideoHTTPController) Playback(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	cmd := commands.PlaybackCommand{
+		VideoID: videoID,
+		UserID:  claims.UserID,
+	}
+
+	output, err := h.controller.Playback(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "PLAYBACK_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondSuccess(w, h.presenter.PresentPlayback(output))
+}
+
+func (h *VideoHTTPController) Playlist(w http.ResponseWriter, r *http.Request) {
+	videoIDStr := chi.URLParam(r, "id")
+	videoID, err := uuid.Parse(videoIDStr)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid video ID")
+		return
+	}
+
+	cmd := commands.PlaylistCommand{
+		VideoID: videoID,
+		Name:    chi.URLParam(r, "*"),
+		Query:   r.URL.Query(),
+	}
+
+	output, err := h.controller.Playlist(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, urlsign.ErrMissingSignature) || errors.Is(err, urlsign.ErrInvalidSignature) || errors.Is(err, urlsign.ErrExpired) {
+			rest.RespondError(w, http.StatusForbidden, "FORBIDDEN", err.Error())
+			return
+		}
+		rest.RespondError(w, http.StatusBadRequest, "PLAYLIST_FAILED", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+	w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", output.ExpiresIn))
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(output.Playlist))
+}
+
+func (h *VideoHTTPController) Workers(w http.ResponseWriter, r *http.Request) {
+	claims, ok := jwt.GetClaimsFromContext(r.Context())
+	if !ok {
+		rest.RespondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing authentication")
+		return
+	}
+
+	output, err := h.controller.Workers(r.Context(), commands.WorkersCommand{UserID: claims.UserID})
+	if err != nil {
+		if errors.Is(err, workers.ErrNotAdmin) {
+			rest.RespondError(w, http.StatusForbidden, "FORBIDDEN", err.Error())
+			return
+		}
+		rest.RespondError(w, http.StatusInternalServerError, "WORKERS_FAILED", err.Error())
+		return
+	}
+
+	rest.RespondSuccess(w, h.presenter.PresentWorkers(output))
+}
+
+func optionalInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
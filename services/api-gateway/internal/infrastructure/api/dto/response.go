@@ -8,6 +8,24 @@ type UploadResponse struct {
 	Status   string `json:"status"`
 }
 
+// UploadURLResponse carries either a single presigned PUT URL or one per
+// part of a multipart upload.
+type UploadURLResponse struct {
+	VideoID   string              `json:"video_id"`
+	Filename  string              `json:"filename"`
+	Method    string              `json:"method"`
+	URL       string              `json:"url,omitempty"`
+	PartSize  int64               `json:"part_size,omitempty"`
+	Parts     []UploadPartURLInfo `json:"parts,omitempty"`
+	ExpiresIn int64               `json:"expires_in"`
+}
+
+type UploadPartURLInfo struct {
+	PartNumber int32  `json:"part_number"`
+	Size       int64  `json:"size"`
+	URL        string `json:"url"`
+}
+
 type VideoInfo struct {
 	ID          string     `json:"id"`
 	Filename    string     `json:"filename"`
@@ -15,6 +33,7 @@ type VideoInfo struct {
 	FrameCount  *int       `json:"frame_count"`
 	CreatedAt   time.Time  `json:"created_at"`
 	CompletedAt *time.Time `json:"completed_at"`
+	PreviewURL  *string    `json:"preview_url,omitempty"`
 }
 
 type ListResponse struct {
@@ -26,18 +45,187 @@ type ListResponse struct {
 }
 
 type StatusResponse struct {
+	VideoID              string     `json:"video_id"`
+	Filename             string     `json:"filename"`
+	Status               string     `json:"status"`
+	FrameCount           *int       `json:"frame_count"`
+	DuplicateFrameCount  *int       `json:"duplicate_frame_count,omitempty"`
+	LowQualityFrameCount *int       `json:"low_quality_frame_count,omitempty"`
+	InactiveFrameCount   *int       `json:"inactive_frame_count,omitempty"`
+	ErrorMessage         *string    `json:"error_message"`
+	ErrorCode            *string    `json:"error_code,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	StartedAt            *time.Time `json:"started_at"`
+	CompletedAt          *time.Time `json:"completed_at"`
+	HasAudio             *bool      `json:"has_audio"`
+	AudioURL             *string    `json:"audio_url,omitempty"`
+
+	AwaitingUpload bool `json:"awaiting_upload,omitempty"`
+
+	QueuePosition         *int64     `json:"queue_position,omitempty"`
+	EstimatedCompletionAt *time.Time `json:"estimated_completion_at,omitempty"`
+
+	SubtitleTracks []SubtitleTrackInfo `json:"subtitle_tracks,omitempty"`
+}
+
+type SubtitleTrackInfo struct {
+	Language string `json:"language"`
+	Codec    string `json:"codec"`
+	SRTURL   string `json:"srt_url"`
+	VTTURL   string `json:"vtt_url"`
+}
+
+type DownloadResponse struct {
+	DownloadURL string `json:"download_url"`
+	Filename    string `json:"filename"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type ContactSheetInfo struct {
+	Index int    `json:"index"`
+	URL   string `json:"url"`
+}
+
+type ContactSheetsResponse struct {
+	VideoID       string             `json:"video_id"`
+	ContactSheets []ContactSheetInfo `json:"contact_sheets"`
+	ExpiresIn     int64              `json:"expires_in"`
+}
+
+type ShotInfo struct {
+	Index         int     `json:"index"`
+	StartTime     float64 `json:"start_time"`
+	EndTime       float64 `json:"end_time"`
+	Duration      float64 `json:"duration"`
+	StartTimecode string  `json:"start_timecode"`
+	EndTimecode   string  `json:"end_timecode"`
+	FrameURL      *string `json:"frame_url,omitempty"`
+}
+
+type FrameInfo struct {
+	Index        int     `json:"index"`
+	Filename     string  `json:"filename"`
+	PTS          float64 `json:"pts"`
+	Timecode     string  `json:"timecode,omitempty"`
+	URL          string  `json:"url"`
+	ThumbnailURL string  `json:"thumbnail_url,omitempty"`
+	Color        string  `json:"color,omitempty"`
+	Weight       float64 `json:"weight,omitempty"`
+}
+
+type FramesResponse struct {
+	VideoID   string      `json:"video_id"`
+	Frames    []FrameInfo `json:"frames"`
+	Total     int         `json:"total"`
+	Limit     int         `json:"limit"`
+	Offset    int         `json:"offset"`
+	HasMore   bool        `json:"has_more"`
+	ExpiresIn int64       `json:"expires_in"`
+}
+
+type FrameResponse struct {
+	VideoID   string  `json:"video_id"`
+	Index     int     `json:"index"`
+	Filename  string  `json:"filename"`
+	PTS       float64 `json:"pts"`
+	Timecode  string  `json:"timecode,omitempty"`
+	URL       string  `json:"url"`
+	ExpiresIn int64   `json:"expires_in"`
+}
+
+type FrameTransformResponse struct {
+	VideoID   string `json:"video_id"`
+	Index     int    `json:"index"`
+	URL       string `json:"url"`
+	ExpiresIn int64  `json:"expires_in"`
+}
+
+type ActivitySegment struct {
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+}
+
+type ActivityResponse struct {
+	VideoID  string            `json:"video_id"`
+	Interval float64           `json:"interval"`
+	Scores   []int             `json:"scores"`
+	Segments []ActivitySegment `json:"segments"`
+}
+
+type ShotsResponse struct {
+	VideoID   string     `json:"video_id"`
+	FrameRate int        `json:"frame_rate"`
+	Shots     []ShotInfo `json:"shots"`
+}
+
+type RenderResponse struct {
+	RenderID     string     `json:"render_id"`
 	VideoID      string     `json:"video_id"`
-	Filename     string     `json:"filename"`
 	Status       string     `json:"status"`
+	Format       string     `json:"format"`
+	FPS          float64    `json:"fps"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
 	FrameCount   *int       `json:"frame_count"`
+	URL          *string    `json:"url,omitempty"`
+	ExpiresIn    int64      `json:"expires_in,omitempty"`
 	ErrorMessage *string    `json:"error_message"`
 	CreatedAt    time.Time  `json:"created_at"`
 	StartedAt    *time.Time `json:"started_at"`
 	CompletedAt  *time.Time `json:"completed_at"`
 }
 
-type DownloadResponse struct {
-	DownloadURL string `json:"download_url"`
-	Filename    string `json:"filename"`
-	ExpiresIn   int64  `json:"expires_in"`
+type ClipInfo struct {
+	ClipID       string     `json:"clip_id"`
+	Index        int        `json:"index"`
+	StartTime    float64    `json:"start_time"`
+	EndTime      float64    `json:"end_time"`
+	Status       string     `json:"status"`
+	Mode         *string    `json:"mode,omitempty"`
+	SizeBytes    *int64     `json:"size_bytes,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	ErrorMessage *string    `json:"error_message,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
+
+type ClipsResponse struct {
+	VideoID   string     `json:"video_id"`
+	Clips     []ClipInfo `json:"clips"`
+	ExpiresIn int64      `json:"expires_in,omitempty"`
+}
+
+type PlaybackResponse struct {
+	VideoID   string `json:"video_id"`
+	URL       string `json:"url"`
+	ExpiresIn int64  `json:"expires_in"`
+}
+
+type WatermarkResponse struct {
+	AssetID string `json:"asset_id"`
+	Width   int    `json:"width"`
+	Height  int    `json:"height"`
+}
+
+type WorkerJobInfo struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	VideoID   string    `json:"video_id"`
+	UserID    int64     `json:"user_id"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+type WorkerInfo struct {
+	ID          string          `json:"id"`
+	Host        string          `json:"host"`
+	Version     string          `json:"version"`
+	Capacity    int             `json:"capacity"`
+	Available   int             `json:"available"`
+	StartedAt   time.Time       `json:"started_at"`
+	HeartbeatAt time.Time       `json:"heartbeat_at"`
+	Jobs        []WorkerJobInfo `json:"jobs"`
+}
+
+type WorkersResponse struct {
+	Workers []WorkerInfo `json:"workers"`
 }
@@ -0,0 +1,31 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type clipRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewClipRepository(db *gorm.DB) repositories.ClipRepository {
+	return &clipRepositoryImpl{db: db}
+}
+
+func (r *clipRepositoryImpl) CreateBatch(ctx context.Context, clips []*entities.Clip) error {
+	return r.db.WithContext(ctx).Create(clips).Error
+}
+
+func (r *clipRepositoryImpl) FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.Clip, error) {
+	var clips []*entities.Clip
+	err := r.db.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("clip_index ASC").
+		Find(&clips).Error
+	return clips, err
+}
@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+func TestClipRepository_CreateBatchAndFindByVideoID(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewClipRepository(db)
+	ctx := context.Background()
+
+	videoID := uuid.New()
+	clips := []*entities.Clip{
+		{ID: uuid.New(), VideoID: videoID, UserID: 1, Index: 2, StartTime: 30, EndTime: 45, Status: entities.StatusPending},
+		{ID: uuid.New(), VideoID: videoID, UserID: 1, Index: 1, StartTime: 0, EndTime: 10, Status: entities.StatusPending},
+		{ID: uuid.New(), VideoID: uuid.New(), UserID: 1, Index: 1, StartTime: 0, EndTime: 5, Status: entities.StatusPending},
+	}
+	require.NoError(t, repo.CreateBatch(ctx, clips))
+
+	found, err := repo.FindByVideoID(ctx, videoID)
+
+	assert.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, 1, found[0].Index)
+	assert.Equal(t, 30.0, found[1].StartTime)
+	assert.Nil(t, found[1].Mode)
+}
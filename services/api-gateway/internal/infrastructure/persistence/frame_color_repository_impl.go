@@ -0,0 +1,28 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type frameColorRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFrameColorRepository(db *gorm.DB) repositories.FrameColorRepository {
+	return &frameColorRepositoryImpl{db: db}
+}
+
+func (r *frameColorRepositoryImpl) FindByColor(ctx context.Context, videoID uuid.UUID, red, green, blue, tolerance int) ([]*entities.FrameColor, error) {
+	var colors []*entities.FrameColor
+	err := r.db.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Where("(r - ?) * (r - ?) + (g - ?) * (g - ?) + (b - ?) * (b - ?) <= ?", red, red, green, green, blue, blue, tolerance*tolerance).
+		Order("frame_index ASC, rank ASC").
+		Find(&colors).Error
+	return colors, err
+}
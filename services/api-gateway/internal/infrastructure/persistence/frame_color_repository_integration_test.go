@@ -0,0 +1,36 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+func TestFrameColorRepository_FindByColor(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewFrameColorRepository(db)
+	ctx := context.Background()
+
+	videoID := uuid.New()
+	colors := []*entities.FrameColor{
+		{VideoID: videoID, FrameIndex: 2, Rank: 1, Hex: "#f00a0a", R: 240, G: 10, B: 10, Weight: 0.6},
+		{VideoID: videoID, FrameIndex: 1, Rank: 2, Hex: "#fa0000", R: 250, G: 0, B: 0, Weight: 0.3},
+		{VideoID: videoID, FrameIndex: 1, Rank: 1, Hex: "#0000ff", R: 0, G: 0, B: 255, Weight: 0.7},
+		{VideoID: uuid.New(), FrameIndex: 1, Rank: 1, Hex: "#ff0000", R: 255, G: 0, B: 0, Weight: 1},
+	}
+	require.NoError(t, db.Create(colors).Error)
+
+	found, err := repo.FindByColor(ctx, videoID, 255, 0, 0, 30)
+
+	assert.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, 1, found[0].FrameIndex)
+	assert.Equal(t, "#fa0000", found[0].Hex)
+	assert.Equal(t, 2, found[1].FrameIndex)
+}
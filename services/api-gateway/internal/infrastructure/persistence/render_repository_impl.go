@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type renderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRenderRepository(db *gorm.DB) repositories.RenderRepository {
+	return &renderRepositoryImpl{db: db}
+}
+
+func (r *renderRepositoryImpl) Create(ctx context.Context, render *entities.Render) error {
+	return r.db.WithContext(ctx).Create(render).Error
+}
+
+func (r *renderRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entities.Render, error) {
+	var render entities.Render
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&render).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("render not found")
+		}
+		return nil, err
+	}
+	return &render, nil
+}
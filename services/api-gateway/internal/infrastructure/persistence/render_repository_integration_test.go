@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+func TestRenderRepository_CreateAndFindByID(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewRenderRepository(db)
+	ctx := context.Background()
+
+	render := &entities.Render{
+		ID:      uuid.New(),
+		VideoID: uuid.New(),
+		UserID:  1,
+		Format:  "mp4",
+		FPS:     12.5,
+		Width:   640,
+		Status:  entities.StatusPending,
+	}
+	require.NoError(t, repo.Create(ctx, render))
+
+	found, err := repo.FindByID(ctx, render.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, render.VideoID, found.VideoID)
+	assert.Equal(t, 12.5, found.FPS)
+	assert.Equal(t, entities.StatusPending, found.Status)
+	assert.Nil(t, found.OutputPath)
+}
+
+func TestRenderRepository_FindByID_NotFound(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewRenderRepository(db)
+
+	found, err := repo.FindByID(context.Background(), uuid.New())
+
+	assert.Error(t, err)
+	assert.Nil(t, found)
+	assert.Equal(t, "render not found", err.Error())
+}
@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type shotRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewShotRepository(db *gorm.DB) repositories.ShotRepository {
+	return &shotRepositoryImpl{db: db}
+}
+
+func (r *shotRepositoryImpl) FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.Shot, error) {
+	var shots []*entities.Shot
+	err := r.db.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("shot_index ASC").
+		Find(&shots).Error
+	return shots, err
+}
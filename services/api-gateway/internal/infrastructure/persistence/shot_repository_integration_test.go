@@ -0,0 +1,34 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+func TestShotRepository_FindByVideoID(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewShotRepository(db)
+	ctx := context.Background()
+
+	videoID := uuid.New()
+	shots := []*entities.Shot{
+		{VideoID: videoID, Index: 2, StartTime: 4.2, EndTime: 10},
+		{VideoID: videoID, Index: 1, StartTime: 0, EndTime: 4.2},
+		{VideoID: uuid.New(), Index: 1, StartTime: 0, EndTime: 3},
+	}
+	require.NoError(t, db.Create(shots).Error)
+
+	found, err := repo.FindByVideoID(ctx, videoID)
+
+	assert.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, 1, found[0].Index)
+	assert.Equal(t, 4.2, found[1].StartTime)
+}
@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type subtitleTrackRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSubtitleTrackRepository(db *gorm.DB) repositories.SubtitleTrackRepository {
+	return &subtitleTrackRepositoryImpl{db: db}
+}
+
+func (r *subtitleTrackRepositoryImpl) FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.SubtitleTrack, error) {
+	var tracks []*entities.SubtitleTrack
+	err := r.db.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("stream_index ASC").
+		Find(&tracks).Error
+	return tracks, err
+}
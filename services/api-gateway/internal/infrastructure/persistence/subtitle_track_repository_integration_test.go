@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+func TestSubtitleTrackRepository_FindByVideoID(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewSubtitleTrackRepository(db)
+	ctx := context.Background()
+
+	videoID := uuid.New()
+	tracks := []*entities.SubtitleTrack{
+		{VideoID: videoID, StreamIndex: 3, Language: "fra", Codec: "subrip", SRTPath: "a.srt", VTTPath: "a.vtt"},
+		{VideoID: videoID, StreamIndex: 2, Language: "eng", Codec: "subrip", SRTPath: "b.srt", VTTPath: "b.vtt"},
+		{VideoID: uuid.New(), StreamIndex: 2, Language: "deu", Codec: "ass", SRTPath: "c.srt", VTTPath: "c.vtt"},
+	}
+	require.NoError(t, db.Create(tracks).Error)
+
+	found, err := repo.FindByVideoID(ctx, videoID)
+
+	assert.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, "eng", found[0].Language)
+	assert.Equal(t, "fra", found[1].Language)
+}
+
+func TestSubtitleTrackRepository_FindByVideoID_Empty(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewSubtitleTrackRepository(db)
+
+	found, err := repo.FindByVideoID(context.Background(), uuid.New())
+
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+}
@@ -0,0 +1,58 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type tusUploadRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTusUploadRepository(db *gorm.DB) repositories.TusUploadRepository {
+	return &tusUploadRepositoryImpl{db: db}
+}
+
+func (r *tusUploadRepositoryImpl) Create(ctx context.Context, upload *entities.TusUpload) error {
+	return r.db.WithContext(ctx).Create(upload).Error
+}
+
+func (r *tusUploadRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entities.TusUpload, error) {
+	var upload entities.TusUpload
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&upload).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrTusUploadNotFound
+		}
+		return nil, err
+	}
+	return &upload, nil
+}
+
+func (r *tusUploadRepositoryImpl) UpdateProgress(ctx context.Context, upload *entities.TusUpload, previousOffset int64) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.TusUpload{}).
+		Where("id = ? AND upload_offset = ?", upload.ID, previousOffset).
+		Updates(map[string]interface{}{
+			"upload_offset": upload.Offset,
+			"part_count":    upload.PartCount,
+			"tail":          upload.Tail,
+			"video_id":      upload.VideoID,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrTusUploadConflict
+	}
+	return nil
+}
+
+func (r *tusUploadRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.TusUpload{}).Error
+}
@@ -0,0 +1,96 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+)
+
+func newTestTusUpload() *entities.TusUpload {
+	return &entities.TusUpload{
+		ID:          uuid.New(),
+		UserID:      1,
+		Filename:    "video.mp4",
+		Length:      1024,
+		S3Key:       "uploads/video.mp4",
+		MultipartID: "multipart-1",
+		ExpiresAt:   time.Now().Add(24 * time.Hour),
+	}
+}
+
+func TestTusUploadRepository_CreateAndFindByID(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewTusUploadRepository(db)
+	ctx := context.Background()
+
+	upload := newTestTusUpload()
+	require.NoError(t, repo.Create(ctx, upload))
+
+	found, err := repo.FindByID(ctx, upload.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), found.Length)
+	assert.Equal(t, int64(0), found.Offset)
+	assert.Equal(t, "multipart-1", found.MultipartID)
+	assert.Nil(t, found.VideoID)
+}
+
+func TestTusUploadRepository_FindByID_NotFound(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewTusUploadRepository(db)
+
+	found, err := repo.FindByID(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, repositories.ErrTusUploadNotFound)
+	assert.Nil(t, found)
+}
+
+func TestTusUploadRepository_UpdateProgress(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewTusUploadRepository(db)
+	ctx := context.Background()
+
+	upload := newTestTusUpload()
+	require.NoError(t, repo.Create(ctx, upload))
+
+	upload.Offset = 100
+	upload.Tail = []byte("tail")
+	require.NoError(t, repo.UpdateProgress(ctx, upload, 0))
+
+	found, err := repo.FindByID(ctx, upload.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), found.Offset)
+	assert.Equal(t, []byte("tail"), found.Tail)
+
+	// A second writer that read the upload at offset 0 lost the race.
+	upload.Offset = 50
+	err = repo.UpdateProgress(ctx, upload, 0)
+	assert.ErrorIs(t, err, repositories.ErrTusUploadConflict)
+}
+
+func TestTusUploadRepository_Delete(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewTusUploadRepository(db)
+	ctx := context.Background()
+
+	upload := newTestTusUpload()
+	require.NoError(t, repo.Create(ctx, upload))
+	require.NoError(t, repo.Delete(ctx, upload.ID))
+
+	_, err := repo.FindByID(ctx, upload.ID)
+	assert.ErrorIs(t, err, repositories.ErrTusUploadNotFound)
+}
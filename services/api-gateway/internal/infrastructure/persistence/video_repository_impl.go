@@ -54,9 +54,107 @@ func (r *videoRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (
 	return count, err
 }
 
+// CountActiveByUserID counts the user's videos that are queued or being
+// processed.
+func (r *videoRepositoryImpl) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("user_id = ? AND status IN ? AND NOT awaiting_upload", userID, []entities.VideoStatus{entities.StatusPending, entities.StatusProcessing}).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *videoRepositoryImpl) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("status = ?", status).
+		Count(&count).Error
+	return count, err
+}
+
+// CountQueuedAhead counts the pending videos the queue delivers before the
+// given one: those with a higher priority, and older ones with the same.
+// Videos still awaiting their upload are not queued yet.
+func (r *videoRepositoryImpl) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("status = ? AND id <> ? AND NOT awaiting_upload", entities.StatusPending, video.ID).
+		Where("priority > ? OR (priority = ? AND created_at < ?)", video.Priority, video.Priority, video.CreatedAt).
+		Count(&count).Error
+	return count, err
+}
+
+// FindProcessingStats sums the processing time of the last limit completed
+// videos, only those processed with the given options profile unless it is
+// empty.
+func (r *videoRepositoryImpl) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	recent := r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Select("EXTRACT(EPOCH FROM completed_at - started_at) AS seconds, file_size, source_duration").
+		Where("status = ? AND started_at IS NOT NULL AND completed_at > started_at", entities.StatusCompleted).
+		Order("completed_at DESC").
+		Limit(limit)
+	if profile != "" {
+		recent = recent.Where("options_profile = ?", profile)
+	}
+
+	var stats entities.ProcessingStats
+	err := r.db.WithContext(ctx).
+		Table("(?) AS recent", recent).
+		Select(`COUNT(*) AS count,
+			COALESCE(SUM(seconds), 0) AS seconds,
+			COALESCE(SUM(seconds) FILTER (WHERE file_size > 0), 0) AS sized_seconds,
+			COALESCE(SUM(file_size) FILTER (WHERE file_size > 0), 0) AS bytes,
+			COALESCE(SUM(seconds) FILTER (WHERE source_duration > 0), 0) AS timed_seconds,
+			COALESCE(SUM(source_duration) FILTER (WHERE source_duration > 0), 0) AS source_seconds`).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
 func (r *videoRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
 	return r.db.WithContext(ctx).
 		Model(&entities.Video{}).
 		Where("id = ?", id).
 		Update("status", status).Error
 }
+
+// MarkUploaded queues a video whose direct upload was confirmed, saving the
+// priority and expiry it was queued with. It fails if another request
+// confirmed the upload first.
+func (r *videoRepositoryImpl) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ? AND awaiting_upload", video.ID).
+		Updates(map[string]interface{}{
+			"awaiting_upload":     false,
+			"multipart_upload_id": nil,
+			"priority":            video.Priority,
+			"expires_at":          video.ExpiresAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrUploadConfirmed
+	}
+	return nil
+}
+
+// ReopenUpload undoes MarkUploaded when the video could not be queued, so
+// the upload can be confirmed again.
+func (r *videoRepositoryImpl) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ? AND status = ?", video.ID, entities.StatusPending).
+		Updates(map[string]interface{}{
+			"awaiting_upload":     true,
+			"multipart_upload_id": video.MultipartUploadID,
+			"expires_at":          video.ExpiresAt,
+		}).Error
+}
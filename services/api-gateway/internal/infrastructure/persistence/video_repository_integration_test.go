@@ -11,6 +11,7 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -55,7 +56,7 @@ func setupTestDatabase(t *testing.T) (*gorm.DB, func()) {
 	require.NoError(t, err)
 
 	// Run migrations
-	err = db.AutoMigrate(&entities.Video{})
+	err = db.AutoMigrate(&entities.Video{}, &entities.SubtitleTrack{}, &entities.Shot{}, &entities.FrameColor{}, &entities.Render{}, &entities.Clip{}, &entities.TusUpload{})
 	require.NoError(t, err)
 
 	// Cleanup function
@@ -275,6 +276,178 @@ func TestVideoRepository_CountByUserID(t *testing.T) {
 	assert.Equal(t, int64(0), count)
 }
 
+func TestVideoRepository_CountActiveByUserID(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewVideoRepository(db)
+	ctx := context.Background()
+
+	statuses := []entities.VideoStatus{
+		entities.StatusPending,
+		entities.StatusProcessing,
+		entities.StatusCompleted,
+		entities.StatusFailed,
+	}
+	for _, status := range statuses {
+		video := &entities.Video{
+			UserID:       1,
+			Filename:     "test.mp4",
+			OriginalPath: "uploads/test.mp4",
+			Status:       status,
+			FPS:          30,
+			ExpiresAt:    time.Now().Add(24 * time.Hour),
+		}
+		err := repo.Create(ctx, video)
+		require.NoError(t, err)
+	}
+
+	// Only pending and processing videos count
+	count, err := repo.CountActiveByUserID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = repo.CountActiveByUserID(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestVideoRepository_CountQueuedAhead(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewVideoRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	create := func(status entities.VideoStatus, priority int, createdAt time.Time) *entities.Video {
+		video := &entities.Video{
+			UserID:       1,
+			Filename:     "test.mp4",
+			OriginalPath: "uploads/test.mp4",
+			Status:       status,
+			Priority:     priority,
+			CreatedAt:    createdAt,
+			ExpiresAt:    now.Add(24 * time.Hour),
+		}
+		require.NoError(t, repo.Create(ctx, video))
+		return video
+	}
+
+	create(entities.StatusPending, 5, now.Add(-2*time.Minute))
+	create(entities.StatusPending, 8, now.Add(time.Minute))
+	create(entities.StatusPending, 2, now.Add(-time.Minute))
+	create(entities.StatusProcessing, 8, now.Add(-time.Minute))
+	video := create(entities.StatusPending, 5, now)
+
+	// The older video at the same priority and the newer one at a higher
+	// priority are ahead
+	count, err := repo.CountQueuedAhead(ctx, video)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestVideoRepository_FindProcessingStats(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewVideoRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	create := func(profile string, seconds int, fileSize *int64, duration *float64) {
+		startedAt := now.Add(-time.Duration(seconds) * time.Second)
+		video := &entities.Video{
+			UserID:         1,
+			Filename:       "test.mp4",
+			OriginalPath:   "uploads/test.mp4",
+			Status:         entities.StatusCompleted,
+			FileSize:       fileSize,
+			SourceDuration: duration,
+			OptionsProfile: &profile,
+			StartedAt:      &startedAt,
+			CompletedAt:    &now,
+			ExpiresAt:      now.Add(24 * time.Hour),
+		}
+		require.NoError(t, repo.Create(ctx, video))
+	}
+
+	size := int64(1000)
+	duration := 30.0
+	create("frames", 60, &size, &duration)
+	create("frames", 30, &size, nil)
+	create("shots", 90, nil, nil)
+
+	stats, err := repo.FindProcessingStats(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), stats.Count)
+	assert.InDelta(t, 180, stats.Seconds, 1)
+	assert.InDelta(t, 90, stats.SizedSeconds, 1)
+	assert.Equal(t, int64(2000), stats.Bytes)
+	assert.InDelta(t, 60, stats.TimedSeconds, 1)
+	assert.InDelta(t, 30, stats.SourceSeconds, 0.001)
+
+	stats, err = repo.FindProcessingStats(ctx, "shots", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Count)
+	assert.InDelta(t, 90, stats.Seconds, 1)
+}
+
+func TestVideoRepository_MarkUploaded(t *testing.T) {
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	repo := NewVideoRepository(db)
+	ctx := context.Background()
+
+	multipartID := "multipart-1"
+	video := &entities.Video{
+		UserID:            1,
+		Filename:          "test.mp4",
+		OriginalPath:      "uploads/test.mp4",
+		Status:            entities.StatusPending,
+		FPS:               1,
+		AwaitingUpload:    true,
+		MultipartUploadID: &multipartID,
+		ExpiresAt:         time.Now().Add(time.Hour),
+	}
+	require.NoError(t, repo.Create(ctx, video))
+
+	// Not queued until the upload is confirmed
+	count, err := repo.CountActiveByUserID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	video.Priority = 5
+	video.ExpiresAt = time.Now().Add(24 * time.Hour)
+	require.NoError(t, repo.MarkUploaded(ctx, video))
+
+	found, err := repo.FindByID(ctx, video.ID)
+	require.NoError(t, err)
+	assert.False(t, found.AwaitingUpload)
+	assert.Nil(t, found.MultipartUploadID)
+	assert.Equal(t, 5, found.Priority)
+
+	count, err = repo.CountActiveByUserID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	err = repo.MarkUploaded(ctx, video)
+	assert.ErrorIs(t, err, repositories.ErrUploadConfirmed)
+
+	// Reopened when the job could not be queued, so it can be confirmed again
+	video.ExpiresAt = time.Now().Add(time.Hour)
+	require.NoError(t, repo.ReopenUpload(ctx, video))
+
+	found, err = repo.FindByID(ctx, video.ID)
+	require.NoError(t, err)
+	assert.True(t, found.AwaitingUpload)
+	require.NotNil(t, found.MultipartUploadID)
+	assert.Equal(t, multipartID, *found.MultipartUploadID)
+
+	assert.NoError(t, repo.MarkUploaded(ctx, video))
+}
+
 func TestVideoRepository_UpdateStatus(t *testing.T) {
 	db, cleanup := setupTestDatabase(t)
 	defer cleanup()
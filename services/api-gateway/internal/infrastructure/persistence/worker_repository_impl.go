@@ -0,0 +1,52 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/shared/pkg/database/redis"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+// workerKeyPrefix matches the keys workers register under. Entries expire
+// when a worker stops sending heartbeats, so every key found is alive.
+const workerKeyPrefix = "workers:"
+
+type workerRepositoryImpl struct {
+	client *redis.Client
+}
+
+func NewWorkerRepository(client *redis.Client) repositories.WorkerRepository {
+	return &workerRepositoryImpl{client: client}
+}
+
+func (r *workerRepositoryImpl) FindAlive(ctx context.Context) ([]*entities.Worker, error) {
+	keys, err := r.client.ScanKeys(ctx, workerKeyPrefix+"*")
+	if err != nil {
+		return nil, err
+	}
+
+	workers := make([]*entities.Worker, 0, len(keys))
+	for _, key := range keys {
+		data, err := r.client.Get(ctx, key)
+		if errors.Is(err, redis.Nil) {
+			// Expired between the scan and the read.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var worker entities.Worker
+		if err := json.Unmarshal([]byte(data), &worker); err != nil {
+			logging.Warn("Skipping malformed worker entry", "key", key, "error", err)
+			continue
+		}
+		workers = append(workers, &worker)
+	}
+
+	return workers, nil
+}
@@ -2,15 +2,36 @@ package presenter
 
 import (
 	"github.com/video-platform/services/api-gateway/internal/infrastructure/api/dto"
+	"github.com/video-platform/services/api-gateway/internal/usecase/activity"
+	"github.com/video-platform/services/api-gateway/internal/usecase/clips"
+	"github.com/video-platform/services/api-gateway/internal/usecase/contactsheets"
 	"github.com/video-platform/services/api-gateway/internal/usecase/download"
+	"github.com/video-platform/services/api-gateway/internal/usecase/frames"
 	"github.com/video-platform/services/api-gateway/internal/usecase/list"
+	"github.com/video-platform/services/api-gateway/internal/usecase/playback"
+	"github.com/video-platform/services/api-gateway/internal/usecase/render"
+	"github.com/video-platform/services/api-gateway/internal/usecase/shots"
 	"github.com/video-platform/services/api-gateway/internal/usecase/status"
 	"github.com/video-platform/services/api-gateway/internal/usecase/upload"
+	"github.com/video-platform/services/api-gateway/internal/usecase/watermark"
+	"github.com/video-platform/services/api-gateway/internal/usecase/workers"
 )
 
 type VideoPresenter interface {
 	PresentUpload(output *upload.UploadOutput) *dto.UploadResponse
+	PresentUploadURL(output *upload.UploadURLOutput) *dto.UploadURLResponse
 	PresentList(output *list.ListOutput) *dto.ListResponse
 	PresentStatus(output *status.StatusOutput) *dto.StatusResponse
 	PresentDownload(output *download.DownloadOutput) *dto.DownloadResponse
+	PresentContactSheets(output *contactsheets.ContactSheetsOutput) *dto.ContactSheetsResponse
+	PresentShots(output *shots.ShotsOutput) *dto.ShotsResponse
+	PresentActivity(output *activity.ActivityOutput) *dto.ActivityResponse
+	PresentFrames(output *frames.FramesOutput) *dto.FramesResponse
+	PresentFrame(output *frames.FrameOutput) *dto.FrameResponse
+	PresentFrameTransform(output *frames.FrameTransformOutput) *dto.FrameTransformResponse
+	PresentRender(output *render.RenderOutput) *dto.RenderResponse
+	PresentClips(output *clips.ClipsOutput) *dto.ClipsResponse
+	PresentPlayback(output *playback.PlaybackOutput) *dto.PlaybackResponse
+	PresentWatermark(output *watermark.WatermarkOutput) *dto.WatermarkResponse
+	PresentWorkers(output *workers.WorkersOutput) *dto.WorkersResponse
 }
@@ -2,10 +2,19 @@ package presenter
 
 import (
 	"github.com/video-platform/services/api-gateway/internal/infrastructure/api/dto"
+	"github.com/video-platform/services/api-gateway/internal/usecase/activity"
+	"github.com/video-platform/services/api-gateway/internal/usecase/clips"
+	"github.com/video-platform/services/api-gateway/internal/usecase/contactsheets"
 	"github.com/video-platform/services/api-gateway/internal/usecase/download"
+	"github.com/video-platform/services/api-gateway/internal/usecase/frames"
 	"github.com/video-platform/services/api-gateway/internal/usecase/list"
+	"github.com/video-platform/services/api-gateway/internal/usecase/playback"
+	"github.com/video-platform/services/api-gateway/internal/usecase/render"
+	"github.com/video-platform/services/api-gateway/internal/usecase/shots"
 	"github.com/video-platform/services/api-gateway/internal/usecase/status"
 	"github.com/video-platform/services/api-gateway/internal/usecase/upload"
+	"github.com/video-platform/services/api-gateway/internal/usecase/watermark"
+	"github.com/video-platform/services/api-gateway/internal/usecase/workers"
 )
 
 type videoPresenterImpl struct{}
@@ -22,6 +31,27 @@ func (p *videoPresenterImpl) PresentUpload(output *upload.UploadOutput) *dto.Upl
 	}
 }
 
+func (p *videoPresenterImpl) PresentUploadURL(output *upload.UploadURLOutput) *dto.UploadURLResponse {
+	parts := make([]dto.UploadPartURLInfo, len(output.Parts))
+	for i, part := range output.Parts {
+		parts[i] = dto.UploadPartURLInfo{
+			PartNumber: part.PartNumber,
+			Size:       part.Size,
+			URL:        part.URL,
+		}
+	}
+
+	return &dto.UploadURLResponse{
+		VideoID:   output.VideoID.String(),
+		Filename:  output.Filename,
+		Method:    "PUT",
+		URL:       output.URL,
+		PartSize:  output.PartSize,
+		Parts:     parts,
+		ExpiresIn: output.ExpiresIn,
+	}
+}
+
 func (p *videoPresenterImpl) PresentList(output *list.ListOutput) *dto.ListResponse {
 	videos := make([]dto.VideoInfo, len(output.Videos))
 	for i, v := range output.Videos {
@@ -32,6 +62,7 @@ func (p *videoPresenterImpl) PresentList(output *list.ListOutput) *dto.ListRespo
 			FrameCount:  v.FrameCount,
 			CreatedAt:   v.CreatedAt,
 			CompletedAt: v.CompletedAt,
+			PreviewURL:  v.PreviewURL,
 		}
 	}
 
@@ -45,11 +76,161 @@ func (p *videoPresenterImpl) PresentList(output *list.ListOutput) *dto.ListRespo
 }
 
 func (p *videoPresenterImpl) PresentStatus(output *status.StatusOutput) *dto.StatusResponse {
+	var tracks []dto.SubtitleTrackInfo
+	for _, t := range output.SubtitleTracks {
+		tracks = append(tracks, dto.SubtitleTrackInfo{
+			Language: t.Language,
+			Codec:    t.Codec,
+			SRTURL:   t.SRTURL,
+			VTTURL:   t.VTTURL,
+		})
+	}
+
 	return &dto.StatusResponse{
+		VideoID:              output.VideoID.String(),
+		Filename:             output.Filename,
+		Status:               output.Status,
+		FrameCount:           output.FrameCount,
+		DuplicateFrameCount:  output.DuplicateFrameCount,
+		LowQualityFrameCount: output.LowQualityFrameCount,
+		InactiveFrameCount:   output.InactiveFrameCount,
+		ErrorMessage:         output.ErrorMessage,
+		ErrorCode:            output.ErrorCode,
+		CreatedAt:            output.CreatedAt,
+		StartedAt:            output.StartedAt,
+		CompletedAt:          output.CompletedAt,
+		HasAudio:             output.HasAudio,
+		AudioURL:             output.AudioURL,
+
+		AwaitingUpload:        output.AwaitingUpload,
+		QueuePosition:         output.QueuePosition,
+		EstimatedCompletionAt: output.EstimatedCompletionAt,
+
+		SubtitleTracks: tracks,
+	}
+}
+
+func (p *videoPresenterImpl) PresentDownload(output *download.DownloadOutput) *dto.DownloadResponse {
+	return &dto.DownloadResponse{
+		DownloadURL: output.DownloadURL,
+		Filename:    output.Filename,
+		ExpiresIn:   output.ExpiresIn,
+	}
+}
+
+func (p *videoPresenterImpl) PresentContactSheets(output *contactsheets.ContactSheetsOutput) *dto.ContactSheetsResponse {
+	sheets := make([]dto.ContactSheetInfo, len(output.ContactSheets))
+	for i, sheet := range output.ContactSheets {
+		sheets[i] = dto.ContactSheetInfo{
+			Index: sheet.Index,
+			URL:   sheet.URL,
+		}
+	}
+
+	return &dto.ContactSheetsResponse{
+		VideoID:       output.VideoID.String(),
+		ContactSheets: sheets,
+		ExpiresIn:     output.ExpiresIn,
+	}
+}
+
+func (p *videoPresenterImpl) PresentShots(output *shots.ShotsOutput) *dto.ShotsResponse {
+	infos := make([]dto.ShotInfo, len(output.Shots))
+	for i, shot := range output.Shots {
+		infos[i] = dto.ShotInfo{
+			Index:         shot.Index,
+			StartTime:     shot.StartTime,
+			EndTime:       shot.EndTime,
+			Duration:      shot.Duration,
+			StartTimecode: shot.StartTimecode,
+			EndTimecode:   shot.EndTimecode,
+			FrameURL:      shot.FrameURL,
+		}
+	}
+
+	return &dto.ShotsResponse{
+		VideoID:   output.VideoID.String(),
+		FrameRate: output.FrameRate,
+		Shots:     infos,
+	}
+}
+
+func (p *videoPresenterImpl) PresentActivity(output *activity.ActivityOutput) *dto.ActivityResponse {
+	segments := make([]dto.ActivitySegment, len(output.Segments))
+	for i, segment := range output.Segments {
+		segments[i] = dto.ActivitySegment{
+			StartTime: segment.StartTime,
+			EndTime:   segment.EndTime,
+		}
+	}
+
+	return &dto.ActivityResponse{
+		VideoID:  output.VideoID.String(),
+		Interval: output.Interval,
+		Scores:   output.Scores,
+		Segments: segments,
+	}
+}
+
+func (p *videoPresenterImpl) PresentFrames(output *frames.FramesOutput) *dto.FramesResponse {
+	infos := make([]dto.FrameInfo, len(output.Frames))
+	for i, frame := range output.Frames {
+		infos[i] = dto.FrameInfo{
+			Index:        frame.Index,
+			Filename:     frame.Filename,
+			PTS:          frame.PTS,
+			Timecode:     frame.Timecode,
+			URL:          frame.URL,
+			ThumbnailURL: frame.ThumbnailURL,
+			Color:        frame.Color,
+			Weight:       frame.Weight,
+		}
+	}
+
+	return &dto.FramesResponse{
+		VideoID:   output.VideoID.String(),
+		Frames:    infos,
+		Total:     output.Total,
+		Limit:     output.Limit,
+		Offset:    output.Offset,
+		HasMore:   output.HasMore,
+		ExpiresIn: output.ExpiresIn,
+	}
+}
+
+func (p *videoPresenterImpl) PresentFrame(output *frames.FrameOutput) *dto.FrameResponse {
+	return &dto.FrameResponse{
+		VideoID:   output.VideoID.String(),
+		Index:     output.Index,
+		Filename:  output.Filename,
+		PTS:       output.PTS,
+		Timecode:  output.Timecode,
+		URL:       output.URL,
+		ExpiresIn: output.ExpiresIn,
+	}
+}
+
+func (p *videoPresenterImpl) PresentFrameTransform(output *frames.FrameTransformOutput) *dto.FrameTransformResponse {
+	return &dto.FrameTransformResponse{
+		VideoID:   output.VideoID.String(),
+		Index:     output.Index,
+		URL:       output.URL,
+		ExpiresIn: output.ExpiresIn,
+	}
+}
+
+func (p *videoPresenterImpl) PresentRender(output *render.RenderOutput) *dto.RenderResponse {
+	return &dto.RenderResponse{
+		RenderID:     output.ID.String(),
 		VideoID:      output.VideoID.String(),
-		Filename:     output.Filename,
 		Status:       output.Status,
+		Format:       output.Format,
+		FPS:          output.FPS,
+		Width:        output.Width,
+		Height:       output.Height,
 		FrameCount:   output.FrameCount,
+		URL:          output.URL,
+		ExpiresIn:    output.ExpiresIn,
 		ErrorMessage: output.ErrorMessage,
 		CreatedAt:    output.CreatedAt,
 		StartedAt:    output.StartedAt,
@@ -57,10 +238,72 @@ func (p *videoPresenterImpl) PresentStatus(output *status.StatusOutput) *dto.Sta
 	}
 }
 
-func (p *videoPresenterImpl) PresentDownload(output *download.DownloadOutput) *dto.DownloadResponse {
-	return &dto.DownloadResponse{
-		DownloadURL: output.DownloadURL,
-		Filename:    output.Filename,
-		ExpiresIn:   output.ExpiresIn,
+func (p *videoPresenterImpl) PresentClips(output *clips.ClipsOutput) *dto.ClipsResponse {
+	infos := make([]dto.ClipInfo, len(output.Clips))
+	for i, clip := range output.Clips {
+		infos[i] = dto.ClipInfo{
+			ClipID:       clip.ID.String(),
+			Index:        clip.Index,
+			StartTime:    clip.StartTime,
+			EndTime:      clip.EndTime,
+			Status:       clip.Status,
+			Mode:         clip.Mode,
+			SizeBytes:    clip.SizeBytes,
+			URL:          clip.URL,
+			ErrorMessage: clip.ErrorMessage,
+			CreatedAt:    clip.CreatedAt,
+			CompletedAt:  clip.CompletedAt,
+		}
+	}
+
+	return &dto.ClipsResponse{
+		VideoID:   output.VideoID.String(),
+		Clips:     infos,
+		ExpiresIn: output.ExpiresIn,
+	}
+}
+
+func (p *videoPresenterImpl) PresentPlayback(output *playback.PlaybackOutput) *dto.PlaybackResponse {
+	return &dto.PlaybackResponse{
+		VideoID:   output.VideoID.String(),
+		URL:       output.URL,
+		ExpiresIn: output.ExpiresIn,
+	}
+}
+
+func (p *videoPresenterImpl) PresentWatermark(output *watermark.WatermarkOutput) *dto.WatermarkResponse {
+	return &dto.WatermarkResponse{
+		AssetID: output.AssetID.String(),
+		Width:   output.Width,
+		Height:  output.Height,
 	}
 }
+
+func (p *videoPresenterImpl) PresentWorkers(output *workers.WorkersOutput) *dto.WorkersResponse {
+	infos := make([]dto.WorkerInfo, len(output.Workers))
+	for i, w := range output.Workers {
+		jobs := make([]dto.WorkerJobInfo, len(w.Jobs))
+		for j, job := range w.Jobs {
+			jobs[j] = dto.WorkerJobInfo{
+				Type:      job.Type,
+				ID:        job.ID,
+				VideoID:   job.VideoID,
+				UserID:    job.UserID,
+				StartedAt: job.StartedAt,
+			}
+		}
+
+		infos[i] = dto.WorkerInfo{
+			ID:          w.ID,
+			Host:        w.Host,
+			Version:     w.Version,
+			Capacity:    w.Capacity,
+			Available:   max(w.Capacity-len(w.Jobs), 0),
+			StartedAt:   w.StartedAt,
+			HeartbeatAt: w.HeartbeatAt,
+			Jobs:        jobs,
+		}
+	}
+
+	return &dto.WorkersResponse{Workers: infos}
+}
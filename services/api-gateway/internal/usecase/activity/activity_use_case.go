@@ -0,0 +1,26 @@
+package activity
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type Segment struct {
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+}
+
+// ActivityOutput is the activity timeline: Scores[i] is the percentage of
+// the picture that changed during the Interval seconds before i*Interval.
+type ActivityOutput struct {
+	VideoID  uuid.UUID `json:"video_id"`
+	Interval float64   `json:"interval"`
+	Scores   []int     `json:"scores"`
+	Segments []Segment `json:"segments"`
+}
+
+type ActivityUseCase interface {
+	Execute(ctx context.Context, cmd commands.ActivityCommand) (*ActivityOutput, error)
+}
@@ -0,0 +1,84 @@
+package activity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+// scoreInterval is the spacing of the stored scores, which follow the
+// worker's one frame per second extraction rate.
+const scoreInterval = 1.0
+
+type activityUseCaseImpl struct {
+	videoRepo repositories.VideoRepository
+}
+
+func NewActivityUseCase(videoRepo repositories.VideoRepository) ActivityUseCase {
+	return &activityUseCaseImpl{
+		videoRepo: videoRepo,
+	}
+}
+
+func (uc *activityUseCaseImpl) Execute(ctx context.Context, cmd commands.ActivityCommand) (*ActivityOutput, error) {
+	if cmd.Threshold < 1 || cmd.Threshold > 100 {
+		return nil, errors.New("threshold must be between 1 and 100")
+	}
+
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	if video.ActivityScores == nil {
+		return nil, errors.New("activity timeline not available")
+	}
+
+	scores := make([]int, len(video.ActivityScores))
+	for i, score := range video.ActivityScores {
+		scores[i] = int(score)
+	}
+
+	return &ActivityOutput{
+		VideoID:  video.ID,
+		Interval: scoreInterval,
+		Scores:   scores,
+		Segments: activeSegments(scores, cmd.Threshold),
+	}, nil
+}
+
+// activeSegments merges consecutive scores at or above threshold into
+// segments. A score measures the change since the previous sample, so each
+// segment starts one interval before its first active score.
+func activeSegments(scores []int, threshold int) []Segment {
+	segments := []Segment{}
+
+	for i := 0; i < len(scores); i++ {
+		if scores[i] < threshold {
+			continue
+		}
+
+		start := i
+		for i+1 < len(scores) && scores[i+1] >= threshold {
+			i++
+		}
+
+		segments = append(segments, Segment{
+			StartTime: float64(max(start-1, 0)) * scoreInterval,
+			EndTime:   float64(i) * scoreInterval,
+		})
+	}
+
+	return segments
+}
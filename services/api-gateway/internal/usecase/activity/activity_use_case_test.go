@@ -0,0 +1,197 @@
+package activity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type MockVideoRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoRepository) Create(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entities.Video, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
+func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func TestActivityUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:             videoID,
+		UserID:         1,
+		Status:         entities.StatusCompleted,
+		ActivityScores: []byte{0, 0, 12, 30, 0, 0, 5, 0},
+	}
+
+	cmd := commands.ActivityCommand{
+		VideoID:   videoID,
+		UserID:    1,
+		Threshold: 10,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewActivityUseCase(mockRepo)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 0, 12, 30, 0, 0, 5, 0}, result.Scores)
+	assert.Equal(t, []Segment{{StartTime: 1, EndTime: 3}}, result.Segments)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestActivityUseCase_Execute_NotAvailable(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.ActivityCommand{
+		VideoID:   videoID,
+		UserID:    1,
+		Threshold: 1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewActivityUseCase(mockRepo)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "activity timeline not available", err.Error())
+}
+
+func TestActivityUseCase_Execute_AccessDenied(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 2,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.ActivityCommand{
+		VideoID:   videoID,
+		UserID:    1,
+		Threshold: 1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewActivityUseCase(mockRepo)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "access denied", err.Error())
+}
+
+func TestActivityUseCase_Execute_InvalidThreshold(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+
+	cmd := commands.ActivityCommand{
+		VideoID:   uuid.New(),
+		UserID:    1,
+		Threshold: 0,
+	}
+
+	useCase := NewActivityUseCase(mockRepo)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,39 @@
+package clips
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+// Clip is a requested clip with its processing status. URL is set once the
+// clip is completed.
+type Clip struct {
+	ID           uuid.UUID  `json:"id"`
+	Index        int        `json:"index"`
+	StartTime    float64    `json:"start_time"`
+	EndTime      float64    `json:"end_time"`
+	Status       string     `json:"status"`
+	Mode         *string    `json:"mode,omitempty"`
+	SizeBytes    *int64     `json:"size_bytes,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	ErrorMessage *string    `json:"error_message,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
+
+type ClipsOutput struct {
+	VideoID   uuid.UUID `json:"video_id"`
+	Clips     []Clip    `json:"clips"`
+	ExpiresIn int64     `json:"expires_in,omitempty"`
+}
+
+type CreateClipsUseCase interface {
+	Execute(ctx context.Context, cmd commands.CreateClipsCommand) (*ClipsOutput, error)
+}
+
+type ClipsUseCase interface {
+	Execute(ctx context.Context, cmd commands.ClipsCommand) (*ClipsOutput, error)
+}
@@ -0,0 +1,74 @@
+package clips
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const presignedURLExpiry = 15 * time.Minute
+
+type clipsUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	clipRepo        repositories.ClipRepository
+	s3Client        s3.S3Client
+	processedBucket string
+}
+
+func NewClipsUseCase(
+	videoRepo repositories.VideoRepository,
+	clipRepo repositories.ClipRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) ClipsUseCase {
+	return &clipsUseCaseImpl{
+		videoRepo:       videoRepo,
+		clipRepo:        clipRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+	}
+}
+
+func (uc *clipsUseCaseImpl) Execute(ctx context.Context, cmd commands.ClipsCommand) (*ClipsOutput, error) {
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	clips, err := uc.clipRepo.FindByVideoID(ctx, video.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load clips: %w", err)
+	}
+
+	output := &ClipsOutput{
+		VideoID: video.ID,
+		Clips:   make([]Clip, len(clips)),
+	}
+
+	for i, clip := range clips {
+		output.Clips[i] = presentClip(clip)
+
+		if clip.Status != entities.StatusCompleted || clip.OutputPath == nil {
+			continue
+		}
+
+		url, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, *clip.OutputPath, presignedURLExpiry)
+		if err != nil {
+			return nil, err
+		}
+		output.Clips[i].URL = &url
+		output.ExpiresIn = int64(presignedURLExpiry.Seconds())
+	}
+
+	return output, nil
+}
@@ -0,0 +1,282 @@
+package clips
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockVideoRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoRepository) Create(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entities.Video, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
+func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+type MockClipRepository struct {
+	mock.Mock
+}
+
+func (m *MockClipRepository) CreateBatch(ctx context.Context, clips []*entities.Clip) error {
+	args := m.Called(ctx, clips)
+	return args.Error(0)
+}
+
+func (m *MockClipRepository) FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.Clip, error) {
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Clip), args.Error(1)
+}
+
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, queue string, message interface{}) error {
+	args := m.Called(ctx, queue, message)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) PublishWithOptions(ctx context.Context, queue string, message interface{}, opts rabbitmq.PublishOptions) error {
+	args := m.Called(ctx, queue, message, opts)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func TestClipsUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockClipRepo := new(MockClipRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	outputPath := "processed/" + videoID.String() + "/clips/a.mp4"
+	mode := "REENCODE"
+	failure := "clip starts after the end of the video"
+
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 1}, nil)
+	mockClipRepo.On("FindByVideoID", ctx, videoID).Return([]*entities.Clip{
+		{ID: uuid.New(), Index: 1, StartTime: 0, EndTime: 5, Status: entities.StatusCompleted, Mode: &mode, OutputPath: &outputPath},
+		{ID: uuid.New(), Index: 2, StartTime: 5, EndTime: 10, Status: entities.StatusProcessing},
+		{ID: uuid.New(), Index: 3, StartTime: 90, EndTime: 95, Status: entities.StatusFailed, ErrorMessage: &failure},
+	}, nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", outputPath, 15*time.Minute).Return("https://s3.example.com/clip", nil)
+
+	useCase := NewClipsUseCase(mockRepo, mockClipRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.ClipsCommand{VideoID: videoID, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Clips, 3)
+	assert.Equal(t, "https://s3.example.com/clip", *result.Clips[0].URL)
+	assert.Equal(t, "REENCODE", *result.Clips[0].Mode)
+	assert.Nil(t, result.Clips[1].URL)
+	assert.Equal(t, "FAILED", result.Clips[2].Status)
+	assert.Equal(t, failure, *result.Clips[2].ErrorMessage)
+	assert.Equal(t, int64(900), result.ExpiresIn)
+
+	mockS3.AssertExpectations(t)
+}
+
+func TestClipsUseCase_Execute_AccessDenied(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockClipRepo := new(MockClipRepository)
+
+	videoID := uuid.New()
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 2}, nil)
+
+	useCase := NewClipsUseCase(mockRepo, mockClipRepo, new(MockS3Client), "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.ClipsCommand{VideoID: videoID, UserID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "access denied")
+	mockClipRepo.AssertNotCalled(t, "FindByVideoID", mock.Anything, mock.Anything)
+}
+
+func TestClipsUseCase_Execute_RepositoryError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockClipRepo := new(MockClipRepository)
+
+	videoID := uuid.New()
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 1}, nil)
+	mockClipRepo.On("FindByVideoID", ctx, videoID).Return(nil, errors.New("db error"))
+
+	useCase := NewClipsUseCase(mockRepo, mockClipRepo, new(MockS3Client), "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.ClipsCommand{VideoID: videoID, UserID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failed to load clips")
+}
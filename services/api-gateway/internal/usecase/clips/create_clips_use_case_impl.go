@@ -0,0 +1,145 @@
+package clips
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+)
+
+const (
+	maxClipsPerRequest = 20
+	maxClipDuration    = 10 * 60
+)
+
+type createClipsUseCaseImpl struct {
+	videoRepo repositories.VideoRepository
+	clipRepo  repositories.ClipRepository
+	publisher rabbitmq.Publisher
+}
+
+func NewCreateClipsUseCase(
+	videoRepo repositories.VideoRepository,
+	clipRepo repositories.ClipRepository,
+	publisher rabbitmq.Publisher,
+) CreateClipsUseCase {
+	return &createClipsUseCaseImpl{
+		videoRepo: videoRepo,
+		clipRepo:  clipRepo,
+		publisher: publisher,
+	}
+}
+
+func (uc *createClipsUseCaseImpl) Execute(ctx context.Context, cmd commands.CreateClipsCommand) (*ClipsOutput, error) {
+	if err := validateRanges(cmd.Ranges); err != nil {
+		return nil, err
+	}
+
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	// Clips are numbered across requests so earlier clips keep their index.
+	existing, err := uc.clipRepo.FindByVideoID(ctx, video.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load clips: %w", err)
+	}
+
+	now := time.Now()
+	clips := make([]*entities.Clip, len(cmd.Ranges))
+	ranges := make([]map[string]interface{}, len(cmd.Ranges))
+	for i, r := range cmd.Ranges {
+		clips[i] = &entities.Clip{
+			ID:        uuid.New(),
+			VideoID:   video.ID,
+			UserID:    cmd.UserID,
+			Index:     len(existing) + i + 1,
+			StartTime: r.Start,
+			EndTime:   r.End,
+			Status:    entities.StatusPending,
+			CreatedAt: now,
+		}
+		ranges[i] = map[string]interface{}{
+			"clip_id": clips[i].ID.String(),
+			"start":   r.Start,
+			"end":     r.End,
+		}
+	}
+
+	if err := uc.clipRepo.CreateBatch(ctx, clips); err != nil {
+		return nil, fmt.Errorf("failed to create clip records: %w", err)
+	}
+
+	jobMessage := map[string]interface{}{
+		"video_id": video.ID.String(),
+		"user_id":  cmd.UserID,
+		"s3_key":   video.OriginalPath,
+		"filename": video.Filename,
+		"clips":    ranges,
+	}
+
+	if err := uc.publisher.Publish(ctx, "video.clip.queue", jobMessage); err != nil {
+		return nil, fmt.Errorf("failed to queue clip job: %w", err)
+	}
+
+	output := &ClipsOutput{
+		VideoID: video.ID,
+		Clips:   make([]Clip, len(clips)),
+	}
+	for i, clip := range clips {
+		output.Clips[i] = presentClip(clip)
+	}
+
+	return output, nil
+}
+
+func validateRanges(ranges []commands.ClipRange) error {
+	if len(ranges) == 0 {
+		return errors.New("at least one clip range is required")
+	}
+
+	if len(ranges) > maxClipsPerRequest {
+		return fmt.Errorf("at most %d clips can be requested at once", maxClipsPerRequest)
+	}
+
+	for _, r := range ranges {
+		if r.Start < 0 || r.End <= r.Start {
+			return errors.New("clip ranges must have 0 <= start < end")
+		}
+		if r.End-r.Start > maxClipDuration {
+			return fmt.Errorf("clips must be at most %d seconds long", maxClipDuration)
+		}
+	}
+
+	return nil
+}
+
+func presentClip(clip *entities.Clip) Clip {
+	return Clip{
+		ID:           clip.ID,
+		Index:        clip.Index,
+		StartTime:    clip.StartTime,
+		EndTime:      clip.EndTime,
+		Status:       string(clip.Status),
+		Mode:         clip.Mode,
+		SizeBytes:    clip.SizeBytes,
+		ErrorMessage: clip.ErrorMessage,
+		CreatedAt:    clip.CreatedAt,
+		CompletedAt:  clip.CompletedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package clips
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+func TestCreateClipsUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockClipRepo := new(MockClipRepository)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:           videoID,
+		UserID:       1,
+		Filename:     "talk.mp4",
+		OriginalPath: "uploads/" + videoID.String() + "/talk.mp4",
+		Status:       entities.StatusCompleted,
+	}
+
+	cmd := commands.CreateClipsCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Ranges:  []commands.ClipRange{{Start: 10, End: 20}, {Start: 42.5, End: 60}},
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockClipRepo.On("FindByVideoID", ctx, videoID).Return([]*entities.Clip{{Index: 1}}, nil)
+	mockClipRepo.On("CreateBatch", ctx, mock.MatchedBy(func(clips []*entities.Clip) bool {
+		return len(clips) == 2 && clips[0].Index == 2 && clips[1].Index == 3 && clips[1].StartTime == 42.5
+	})).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.clip.queue", mock.MatchedBy(func(msg map[string]interface{}) bool {
+		clips, ok := msg["clips"].([]map[string]interface{})
+		return ok && len(clips) == 2 && msg["s3_key"] == video.OriginalPath && clips[1]["end"] == 60.0
+	})).Return(nil)
+
+	useCase := NewCreateClipsUseCase(mockRepo, mockClipRepo, mockPublisher)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Clips, 2)
+	assert.Equal(t, "PENDING", result.Clips[0].Status)
+	assert.Equal(t, 2, result.Clips[0].Index)
+	assert.Nil(t, result.Clips[0].URL)
+
+	mockClipRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestCreateClipsUseCase_Execute_InvalidRanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []commands.ClipRange
+		err    string
+	}{
+		{"empty", nil, "at least one clip range is required"},
+		{"too many", make([]commands.ClipRange, 21), "at most 20 clips can be requested at once"},
+		{"negative start", []commands.ClipRange{{Start: -1, End: 5}}, "clip ranges must have 0 <= start < end"},
+		{"reversed", []commands.ClipRange{{Start: 5, End: 5}}, "clip ranges must have 0 <= start < end"},
+		{"too long", []commands.ClipRange{{Start: 0, End: 601}}, "clips must be at most 600 seconds long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockVideoRepository)
+			useCase := NewCreateClipsUseCase(mockRepo, new(MockClipRepository), new(MockPublisher))
+
+			result, err := useCase.Execute(context.Background(), commands.CreateClipsCommand{Ranges: tt.ranges})
+
+			assert.Nil(t, result)
+			assert.EqualError(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateClipsUseCase_Execute_NotCompleted(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockClipRepo := new(MockClipRepository)
+
+	videoID := uuid.New()
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 1, Status: entities.StatusPending}, nil)
+
+	useCase := NewCreateClipsUseCase(mockRepo, mockClipRepo, new(MockPublisher))
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CreateClipsCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Ranges:  []commands.ClipRange{{Start: 0, End: 5}},
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "video processing not completed")
+	mockClipRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+}
+
+func TestCreateClipsUseCase_Execute_PublishError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockClipRepo := new(MockClipRepository)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 1, Status: entities.StatusCompleted}, nil)
+	mockClipRepo.On("FindByVideoID", ctx, videoID).Return([]*entities.Clip{}, nil)
+	mockClipRepo.On("CreateBatch", ctx, mock.Anything).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.clip.queue", mock.Anything).Return(errors.New("broker down"))
+
+	useCase := NewCreateClipsUseCase(mockRepo, mockClipRepo, mockPublisher)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CreateClipsCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Ranges:  []commands.ClipRange{{Start: 0, End: 5}},
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failed to queue clip job")
+}
@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ActivityCommand requests the activity timeline of a video. Segments are
+// the runs of seconds whose score reaches Threshold.
+type ActivityCommand struct {
+	VideoID   uuid.UUID
+	UserID    int64
+	Threshold int
+}
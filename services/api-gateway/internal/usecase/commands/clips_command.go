@@ -0,0 +1,21 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ClipRange is a requested clip, in seconds from the start of the video.
+type ClipRange struct {
+	Start float64
+	End   float64
+}
+
+// CreateClipsCommand queues one clip per range, cut from the source video.
+type CreateClipsCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+	Ranges  []ClipRange
+}
+
+type ClipsCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+}
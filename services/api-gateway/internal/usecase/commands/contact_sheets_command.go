@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type ContactSheetsCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+}
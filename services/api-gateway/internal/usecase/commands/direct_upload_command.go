@@ -0,0 +1,17 @@
+package commands
+
+import "github.com/google/uuid"
+
+// CreateUploadURLCommand reserves a video for a file of FileSize bytes that
+// the client uploads straight to S3.
+type CreateUploadURLCommand struct {
+	UserID   int64
+	Filename string
+	FileSize int64
+	Options  ProcessingOptions
+}
+
+type CompleteUploadCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+}
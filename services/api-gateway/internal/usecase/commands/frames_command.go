@@ -0,0 +1,38 @@
+package commands
+
+import "github.com/google/uuid"
+
+// FramesCommand pages through the frames of a video. When Color, given as
+// #rrggbb, is set only frames with a dominant color within Tolerance of it
+// are returned.
+type FramesCommand struct {
+	VideoID   uuid.UUID
+	UserID    int64
+	Color     string
+	Tolerance int
+	Limit     int
+	Offset    int
+}
+
+// FrameCommand requests a single frame. With Proxy the image itself is
+// returned instead of a presigned URL.
+type FrameCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+	Index   int
+	Proxy   bool
+}
+
+// FrameTransformCommand requests a signed storage service URL for a
+// resized or re-encoded copy of a frame. Zero values leave a parameter to
+// the storage service default.
+type FrameTransformCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+	Index   int
+	Width   int
+	Height  int
+	Fit     string
+	Format  string
+	Quality int
+}
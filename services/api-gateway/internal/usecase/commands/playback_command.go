@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+type PlaybackCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+}
+
+// PlaylistCommand fetches one playlist of the HLS ladder by its path below
+// the video's hls/ prefix. It is authorized by the signed Query rather than
+// by a user.
+type PlaylistCommand struct {
+	VideoID uuid.UUID
+	Name    string
+	Query   url.Values
+}
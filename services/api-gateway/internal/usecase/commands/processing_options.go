@@ -0,0 +1,89 @@
+package commands
+
+type ProcessingOptions struct {
+	Mosaic    MosaicOptions    `json:"mosaic"`
+	Preview   PreviewOptions   `json:"preview"`
+	Audio     AudioOptions     `json:"audio"`
+	Subtitles SubtitleOptions  `json:"subtitles"`
+	Dedupe    DedupeOptions    `json:"dedupe"`
+	Quality   QualityOptions   `json:"quality"`
+	Shots     ShotOptions      `json:"shots"`
+	Activity  ActivityOptions  `json:"activity"`
+	Colors    ColorOptions     `json:"colors"`
+	HLS       HLSOptions       `json:"hls"`
+	Watermark WatermarkOptions `json:"watermark"`
+	Video     VideoOptions     `json:"video"`
+	Priority  string           `json:"priority,omitempty"`
+}
+
+type MosaicOptions struct {
+	Columns     int   `json:"columns,omitempty"`
+	Rows        int   `json:"rows,omitempty"`
+	ThumbWidth  int   `json:"thumb_width,omitempty"`
+	ThumbHeight int   `json:"thumb_height,omitempty"`
+	Captions    *bool `json:"captions,omitempty"`
+}
+
+type PreviewOptions struct {
+	Format   string `json:"format,omitempty"`
+	Duration int    `json:"duration,omitempty"`
+	Width    int    `json:"width,omitempty"`
+}
+
+type AudioOptions struct {
+	Format       string `json:"format,omitempty"`
+	IncludeInZip bool   `json:"include_in_zip,omitempty"`
+}
+
+type SubtitleOptions struct {
+	IncludeInZip bool `json:"include_in_zip,omitempty"`
+}
+
+type DedupeOptions struct {
+	Enabled     bool `json:"enabled,omitempty"`
+	MaxDistance *int `json:"max_distance,omitempty"`
+}
+
+type QualityOptions struct {
+	Enabled      bool     `json:"enabled,omitempty"`
+	MinLuminance *float64 `json:"min_luminance,omitempty"`
+	MinVariance  *float64 `json:"min_variance,omitempty"`
+	MinSharpness *float64 `json:"min_sharpness,omitempty"`
+}
+
+type ShotOptions struct {
+	Enabled   bool     `json:"enabled,omitempty"`
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+type ActivityOptions struct {
+	Enabled        bool `json:"enabled,omitempty"`
+	KeepActiveOnly bool `json:"keep_active_only,omitempty"`
+	Threshold      *int `json:"threshold,omitempty"`
+	Padding        *int `json:"padding,omitempty"`
+}
+
+type ColorOptions struct {
+	Enabled bool `json:"enabled,omitempty"`
+	Count   int  `json:"count,omitempty"`
+}
+
+type HLSOptions struct {
+	Enabled    bool  `json:"enabled,omitempty"`
+	Renditions []int `json:"renditions,omitempty"`
+}
+
+type WatermarkOptions struct {
+	AssetID  string   `json:"asset_id,omitempty"`
+	Text     string   `json:"text,omitempty"`
+	Position string   `json:"position,omitempty"`
+	Opacity  *float64 `json:"opacity,omitempty"`
+	FontSize int      `json:"font_size,omitempty"`
+}
+
+type VideoOptions struct {
+	StreamIndex *int   `json:"stream_index,omitempty"`
+	Rotation    *int   `json:"rotation,omitempty"`
+	Deinterlace string `json:"deinterlace,omitempty"`
+	ToneMap     string `json:"tone_map,omitempty"`
+}
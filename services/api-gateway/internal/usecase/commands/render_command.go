@@ -0,0 +1,23 @@
+package commands
+
+import "github.com/google/uuid"
+
+// RenderCommand assembles frames of a video into a new video. Frames lists
+// frame indexes in playback order and may repeat; when empty every frame is
+// used. Width and Height of zero keep the frame size, and a single zero
+// dimension follows the aspect ratio.
+type RenderCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+	Frames  []int
+	Format  string
+	FPS     float64
+	Width   int
+	Height  int
+}
+
+type RenderStatusCommand struct {
+	VideoID  uuid.UUID
+	RenderID uuid.UUID
+	UserID   int64
+}
@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ShotsCommand requests the shot list of a video. Format is one of "json",
+// "csv" or "edl"; empty means json.
+type ShotsCommand struct {
+	VideoID uuid.UUID
+	UserID  int64
+	Format  string
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// CreateTusUploadCommand starts a resumable upload of Length bytes. The
+// video is queued with Options once the last byte arrives.
+type CreateTusUploadCommand struct {
+	UserID   int64
+	Filename string
+	Length   int64
+	Options  ProcessingOptions
+}
+
+type TusUploadOffsetCommand struct {
+	UploadID uuid.UUID
+	UserID   int64
+}
+
+// WriteTusChunkCommand appends Body to an upload. Offset is where the client
+// believes the upload stands and must match the received byte count.
+type WriteTusChunkCommand struct {
+	UploadID uuid.UUID
+	UserID   int64
+	Offset   int64
+	Body     io.Reader
+}
+
+type TerminateTusUploadCommand struct {
+	UploadID uuid.UUID
+	UserID   int64
+}
@@ -8,4 +8,5 @@ type UploadCommand struct {
 	ContentType string
 	FileSize    int64
 	FileReader  io.Reader
+	Options     ProcessingOptions
 }
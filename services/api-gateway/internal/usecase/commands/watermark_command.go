@@ -0,0 +1,9 @@
+package commands
+
+import "io"
+
+type UploadWatermarkCommand struct {
+	UserID     int64
+	FileSize   int64
+	FileReader io.Reader
+}
@@ -0,0 +1,7 @@
+package commands
+
+// WorkersCommand lists the live processing workers. Only the users the
+// gateway is configured with as admins may run it.
+type WorkersCommand struct {
+	UserID int64
+}
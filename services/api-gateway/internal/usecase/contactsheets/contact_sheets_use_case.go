@@ -0,0 +1,23 @@
+package contactsheets
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type ContactSheet struct {
+	Index int    `json:"index"`
+	URL   string `json:"url"`
+}
+
+type ContactSheetsOutput struct {
+	VideoID       uuid.UUID      `json:"video_id"`
+	ContactSheets []ContactSheet `json:"contact_sheets"`
+	ExpiresIn     int64          `json:"expires_in"`
+}
+
+type ContactSheetsUseCase interface {
+	Execute(ctx context.Context, cmd commands.ContactSheetsCommand) (*ContactSheetsOutput, error)
+}
@@ -0,0 +1,75 @@
+package contactsheets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const presignedURLExpiry = 15 * time.Minute
+
+type contactSheetsUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	s3Client        s3.S3Client
+	processedBucket string
+}
+
+func NewContactSheetsUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) ContactSheetsUseCase {
+	return &contactSheetsUseCaseImpl{
+		videoRepo:       videoRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+	}
+}
+
+func (uc *contactSheetsUseCaseImpl) Execute(ctx context.Context, cmd commands.ContactSheetsCommand) (*ContactSheetsOutput, error) {
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	keys, err := uc.s3Client.ListObjects(ctx, uc.processedBucket, fmt.Sprintf("processed/%s/contact-sheets/", video.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contact sheets: %w", err)
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("contact sheets not available")
+	}
+
+	sort.Strings(keys)
+
+	sheets := make([]ContactSheet, len(keys))
+	for i, key := range keys {
+		url, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, key, presignedURLExpiry)
+		if err != nil {
+			return nil, err
+		}
+		sheets[i] = ContactSheet{Index: i + 1, URL: url}
+	}
+
+	return &ContactSheetsOutput{
+		VideoID:       video.ID,
+		ContactSheets: sheets,
+		ExpiresIn:     int64(presignedURLExpiry.Seconds()),
+	}, nil
+}
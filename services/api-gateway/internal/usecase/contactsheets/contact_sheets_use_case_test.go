@@ -0,0 +1,342 @@
+package contactsheets
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockVideoRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoRepository) Create(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entities.Video, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
+func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func TestContactSheetsUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:       videoID,
+		UserID:   1,
+		Filename: "test.mp4",
+		Status:   entities.StatusCompleted,
+	}
+
+	cmd := commands.ContactSheetsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	prefix := "processed/" + videoID.String() + "/contact-sheets/"
+	keys := []string{prefix + "contact_002.jpg", prefix + "contact_001.jpg"}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("ListObjects", ctx, "processed-bucket", prefix).Return(keys, nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"contact_001.jpg", 15*time.Minute).Return("https://s3.example.com/contact_001", nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"contact_002.jpg", 15*time.Minute).Return("https://s3.example.com/contact_002", nil)
+
+	useCase := NewContactSheetsUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Len(t, result.ContactSheets, 2)
+	assert.Equal(t, 1, result.ContactSheets[0].Index)
+	assert.Equal(t, "https://s3.example.com/contact_001", result.ContactSheets[0].URL)
+	assert.Equal(t, "https://s3.example.com/contact_002", result.ContactSheets[1].URL)
+	assert.Equal(t, int64(900), result.ExpiresIn)
+
+	mockRepo.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+}
+
+func TestContactSheetsUseCase_Execute_AccessDenied(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 2,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.ContactSheetsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewContactSheetsUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "access denied", err.Error())
+
+	mockS3.AssertNotCalled(t, "ListObjects", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestContactSheetsUseCase_Execute_NotCompleted(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusProcessing,
+	}
+
+	cmd := commands.ContactSheetsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewContactSheetsUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "video processing not completed", err.Error())
+}
+
+func TestContactSheetsUseCase_Execute_NoContactSheets(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.ContactSheetsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("ListObjects", ctx, "processed-bucket", mock.AnythingOfType("string")).Return([]string{}, nil)
+
+	useCase := NewContactSheetsUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "contact sheets not available", err.Error())
+
+	mockS3.AssertExpectations(t)
+}
+
+func TestContactSheetsUseCase_Execute_ListObjectsError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.ContactSheetsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("ListObjects", ctx, "processed-bucket", mock.AnythingOfType("string")).Return(nil, errors.New("s3 error"))
+
+	useCase := NewContactSheetsUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failed to list contact sheets")
+}
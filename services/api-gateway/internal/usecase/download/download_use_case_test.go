@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
 type MockVideoRepository struct {
@@ -44,11 +45,44 @@ func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
 func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
 	args := m.Called(ctx, id, status)
 	return args.Error(0)
 }
 
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
 type MockS3Client struct {
 	mock.Mock
 }
@@ -86,6 +120,16 @@ func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key str
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
 	args := m.Called(ctx, bucket, prefix)
 	if args.Get(0) == nil {
@@ -94,6 +138,34 @@ func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) (
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
 func TestDownloadUseCase_Execute_Success(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
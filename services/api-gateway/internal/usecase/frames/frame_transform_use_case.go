@@ -0,0 +1,21 @@
+package frames
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+// FrameTransformOutput is a signed storage service URL serving a resized,
+// cropped or re-encoded copy of a frame.
+type FrameTransformOutput struct {
+	VideoID   uuid.UUID `json:"video_id"`
+	Index     int       `json:"index"`
+	URL       string    `json:"url"`
+	ExpiresIn int64     `json:"expires_in"`
+}
+
+type FrameTransformUseCase interface {
+	Execute(ctx context.Context, cmd commands.FrameTransformCommand) (*FrameTransformOutput, error)
+}
@@ -0,0 +1,154 @@
+package frames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+	"github.com/video-platform/shared/pkg/urlsign"
+)
+
+// These mirror the storage service, which checks them again; rejecting a
+// bad transform here saves handing out a URL that can only fail.
+const (
+	maxTransformDimension = 4096
+
+	fitContain = "contain"
+	fitCover   = "cover"
+	fitFill    = "fill"
+
+	formatJPEG = "jpeg"
+	formatPNG  = "png"
+)
+
+type frameTransformUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	s3Client        s3.S3Client
+	processedBucket string
+	storageURL      string
+	signingKey      string
+}
+
+func NewFrameTransformUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+	storageURL string,
+	signingKey string,
+) FrameTransformUseCase {
+	return &frameTransformUseCaseImpl{
+		videoRepo:       videoRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+		storageURL:      strings.TrimSuffix(storageURL, "/"),
+		signingKey:      signingKey,
+	}
+}
+
+func (uc *frameTransformUseCaseImpl) Execute(ctx context.Context, cmd commands.FrameTransformCommand) (*FrameTransformOutput, error) {
+	if uc.signingKey == "" {
+		return nil, errors.New("frame transforms are not enabled")
+	}
+
+	query, err := transformQuery(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	frames, err := loadFrames(ctx, uc.s3Client, uc.processedBucket, video)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame *manifestFrame
+	for i := range frames {
+		if frames[i].Index == cmd.Index {
+			frame = &frames[i]
+			break
+		}
+	}
+
+	if frame == nil {
+		return nil, errors.New("frame not found")
+	}
+
+	expires := time.Now().Add(presignedURLExpiry)
+	return &FrameTransformOutput{
+		VideoID:   video.ID,
+		Index:     frame.Index,
+		URL:       transformURL(uc.storageURL, uc.signingKey, video, frame.Filename, query, expires),
+		ExpiresIn: int64(presignedURLExpiry.Seconds()),
+	}, nil
+}
+
+// transformQuery validates the transform and returns the parameters that
+// differ from the storage service defaults.
+func transformQuery(cmd commands.FrameTransformCommand) (url.Values, error) {
+	if cmd.Width < 0 || cmd.Height < 0 || cmd.Width > maxTransformDimension || cmd.Height > maxTransformDimension {
+		return nil, fmt.Errorf("width and height must be between 0 and %d", maxTransformDimension)
+	}
+
+	switch cmd.Fit {
+	case "", fitContain, fitCover, fitFill:
+	default:
+		return nil, errors.New("fit must be contain, cover or fill")
+	}
+
+	switch cmd.Format {
+	case "", "jpg", formatJPEG:
+		if cmd.Quality < 0 || cmd.Quality > 100 {
+			return nil, errors.New("quality must be between 1 and 100")
+		}
+	case formatPNG:
+		if cmd.Quality != 0 {
+			return nil, errors.New("quality only applies to jpeg")
+		}
+	default:
+		return nil, errors.New("format must be jpeg or png")
+	}
+
+	query := url.Values{}
+	if cmd.Width > 0 {
+		query.Set("width", strconv.Itoa(cmd.Width))
+	}
+	if cmd.Height > 0 {
+		query.Set("height", strconv.Itoa(cmd.Height))
+	}
+	if cmd.Fit != "" {
+		query.Set("fit", cmd.Fit)
+	}
+	if cmd.Format != "" {
+		query.Set("format", cmd.Format)
+	}
+	if cmd.Quality > 0 {
+		query.Set("quality", strconv.Itoa(cmd.Quality))
+	}
+	return query, nil
+}
+
+// transformURL signs a storage service transform URL for a frame of video.
+func transformURL(storageURL, signingKey string, video *entities.Video, filename string, query url.Values, expires time.Time) string {
+	path := urlsign.FramePath(video.ID.String(), filename)
+	return storageURL + path + "?" + urlsign.Sign(signingKey, path, query, expires)
+}
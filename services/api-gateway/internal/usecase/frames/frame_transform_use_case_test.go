@@ -0,0 +1,187 @@
+package frames
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/urlsign"
+)
+
+func TestFrameTransformUseCase_Execute_SignsTransform(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FrameTransformCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Index:   3,
+		Width:   640,
+		Height:  360,
+		Fit:     "cover",
+		Format:  "jpeg",
+		Quality: 70,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(5), nil)
+
+	// The storage service sits behind a prefixing proxy.
+	useCase := NewFrameTransformUseCase(mockRepo, mockS3, "processed-bucket", "https://cdn.example.com/storage/", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.Index)
+	assert.Equal(t, int64(900), result.ExpiresIn)
+
+	signed, err := url.Parse(result.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, "/storage/frames/"+videoID.String()+"/frame_0003.jpg", signed.Path)
+
+	query := signed.Query()
+	assert.Equal(t, "640", query.Get("width"))
+	assert.Equal(t, "360", query.Get("height"))
+	assert.Equal(t, "cover", query.Get("fit"))
+	assert.Equal(t, "jpeg", query.Get("format"))
+	assert.Equal(t, "70", query.Get("quality"))
+	assert.NoError(t, urlsign.Verify("secret", urlsign.FramePath(videoID.String(), "frame_0003.jpg"), query, time.Now()))
+
+	query.Set("width", "4096")
+	assert.ErrorIs(t, urlsign.Verify("secret", urlsign.FramePath(videoID.String(), "frame_0003.jpg"), query, time.Now()), urlsign.ErrInvalidSignature)
+}
+
+func TestFrameTransformUseCase_Execute_OmitsDefaults(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FrameTransformCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Index:   1,
+		Format:  "png",
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(5), nil)
+
+	useCase := NewFrameTransformUseCase(mockRepo, mockS3, "processed-bucket", "http://storage.example.com", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+
+	signed, err := url.Parse(result.URL)
+	assert.NoError(t, err)
+	query := signed.Query()
+	assert.Equal(t, "png", query.Get("format"))
+	for _, param := range []string{"width", "height", "fit", "quality"} {
+		assert.False(t, query.Has(param), param)
+	}
+}
+
+func TestFrameTransformUseCase_Execute_InvalidTransform(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmd     commands.FrameTransformCommand
+		wantErr string
+	}{
+		{"negative width", commands.FrameTransformCommand{Width: -1}, "width and height must be between 0 and 4096"},
+		{"height too large", commands.FrameTransformCommand{Height: 4097}, "width and height must be between 0 and 4096"},
+		{"unknown fit", commands.FrameTransformCommand{Fit: "stretch"}, "fit must be contain, cover or fill"},
+		{"unknown format", commands.FrameTransformCommand{Format: "webp"}, "format must be jpeg or png"},
+		{"quality too high", commands.FrameTransformCommand{Quality: 101}, "quality must be between 1 and 100"},
+		{"quality for png", commands.FrameTransformCommand{Format: "png", Quality: 80}, "quality only applies to jpeg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := new(MockVideoRepository)
+			mockS3 := new(MockS3Client)
+			useCase := NewFrameTransformUseCase(mockRepo, mockS3, "processed-bucket", "http://storage.example.com", "secret")
+
+			tt.cmd.VideoID = uuid.New()
+			tt.cmd.UserID = 1
+			tt.cmd.Index = 1
+
+			// Act
+			result, err := useCase.Execute(context.Background(), tt.cmd)
+
+			// Assert
+			assert.Nil(t, result)
+			assert.EqualError(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestFrameTransformUseCase_Execute_NotEnabled(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	useCase := NewFrameTransformUseCase(mockRepo, mockS3, "processed-bucket", "http://storage.example.com", "")
+
+	// Act
+	result, err := useCase.Execute(context.Background(), commands.FrameTransformCommand{VideoID: uuid.New(), UserID: 1, Index: 1, Width: 320})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "frame transforms are not enabled")
+}
+
+func TestFrameTransformUseCase_Execute_AccessDenied(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 2,
+		Status: entities.StatusCompleted,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewFrameTransformUseCase(mockRepo, mockS3, "processed-bucket", "http://storage.example.com", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.FrameTransformCommand{VideoID: videoID, UserID: 1, Index: 1, Width: 320})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "access denied")
+	mockS3.AssertNotCalled(t, "GetObject", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,26 @@
+package frames
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+// FrameOutput describes a single frame. Image is set instead of URL when
+// the frame is proxied; the caller must close it.
+type FrameOutput struct {
+	VideoID   uuid.UUID     `json:"video_id"`
+	Index     int           `json:"index"`
+	Filename  string        `json:"filename"`
+	PTS       float64       `json:"pts"`
+	Timecode  string        `json:"timecode,omitempty"`
+	URL       string        `json:"url,omitempty"`
+	ExpiresIn int64         `json:"expires_in,omitempty"`
+	Image     io.ReadCloser `json:"-"`
+}
+
+type FrameUseCase interface {
+	Execute(ctx context.Context, cmd commands.FrameCommand) (*FrameOutput, error)
+}
@@ -0,0 +1,87 @@
+package frames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type frameUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	s3Client        s3.S3Client
+	processedBucket string
+}
+
+func NewFrameUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) FrameUseCase {
+	return &frameUseCaseImpl{
+		videoRepo:       videoRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+	}
+}
+
+func (uc *frameUseCaseImpl) Execute(ctx context.Context, cmd commands.FrameCommand) (*FrameOutput, error) {
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	frames, err := loadFrames(ctx, uc.s3Client, uc.processedBucket, video)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame *manifestFrame
+	for i := range frames {
+		if frames[i].Index == cmd.Index {
+			frame = &frames[i]
+			break
+		}
+	}
+
+	if frame == nil {
+		return nil, errors.New("frame not found")
+	}
+
+	output := &FrameOutput{
+		VideoID:  video.ID,
+		Index:    frame.Index,
+		Filename: frame.Filename,
+		PTS:      frame.PTS,
+		Timecode: frame.Timecode,
+	}
+
+	key := framesPrefix(video) + frame.Filename
+	if cmd.Proxy {
+		output.Image, err = uc.s3Client.GetObject(ctx, uc.processedBucket, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read frame: %w", err)
+		}
+		return output, nil
+	}
+
+	output.URL, err = uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, key, presignedURLExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate frame URL: %w", err)
+	}
+	output.ExpiresIn = int64(presignedURLExpiry.Seconds())
+
+	return output, nil
+}
@@ -0,0 +1,126 @@
+package frames
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+func TestFrameUseCase_Execute_PresignedURL(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FrameCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Index:   3,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(5), nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", "processed/"+videoID.String()+"/frames/frame_0003.jpg", 15*time.Minute).
+		Return("https://s3.example.com/frame_0003", nil)
+
+	useCase := NewFrameUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "https://s3.example.com/frame_0003", result.URL)
+	assert.Equal(t, 2.5, result.PTS)
+	assert.Equal(t, int64(900), result.ExpiresIn)
+	assert.Nil(t, result.Image)
+}
+
+func TestFrameUseCase_Execute_Proxy(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FrameCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Index:   3,
+		Proxy:   true,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(5), nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/frames/frame_0003.jpg").
+		Return(io.NopCloser(strings.NewReader("jpeg")), nil)
+
+	useCase := NewFrameUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(result.Image)
+	assert.Equal(t, "jpeg", string(body))
+	assert.Empty(t, result.URL)
+	mockS3.AssertNotCalled(t, "GeneratePresignedURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFrameUseCase_Execute_NotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FrameCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Index:   42,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(5), nil)
+
+	useCase := NewFrameUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "frame not found", err.Error())
+}
@@ -0,0 +1,37 @@
+package frames
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+// Frame is an uploaded frame with its presentation timestamp. For a color
+// search Color is the closest of its dominant colors and Weight the share
+// of the frame that color covers. ThumbnailURL is a signed storage service
+// URL for a downscaled copy, set when transform signing is configured.
+type Frame struct {
+	Index        int     `json:"index"`
+	Filename     string  `json:"filename"`
+	PTS          float64 `json:"pts"`
+	Timecode     string  `json:"timecode,omitempty"`
+	URL          string  `json:"url"`
+	ThumbnailURL string  `json:"thumbnail_url,omitempty"`
+	Color        string  `json:"color,omitempty"`
+	Weight       float64 `json:"weight,omitempty"`
+}
+
+type FramesOutput struct {
+	VideoID   uuid.UUID `json:"video_id"`
+	Frames    []Frame   `json:"frames"`
+	Total     int       `json:"total"`
+	Limit     int       `json:"limit"`
+	Offset    int       `json:"offset"`
+	HasMore   bool      `json:"has_more"`
+	ExpiresIn int64     `json:"expires_in"`
+}
+
+type FramesUseCase interface {
+	Execute(ctx context.Context, cmd commands.FramesCommand) (*FramesOutput, error)
+}
@@ -0,0 +1,155 @@
+package frames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const (
+	presignedURLExpiry = 15 * time.Minute
+
+	// maxColorTolerance is the RGB distance between black and white.
+	maxColorTolerance = 442
+
+	thumbnailWidth = 320
+)
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type framesUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	colorRepo       repositories.FrameColorRepository
+	s3Client        s3.S3Client
+	processedBucket string
+	storageURL      string
+	signingKey      string
+}
+
+func NewFramesUseCase(
+	videoRepo repositories.VideoRepository,
+	colorRepo repositories.FrameColorRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+	storageURL string,
+	signingKey string,
+) FramesUseCase {
+	return &framesUseCaseImpl{
+		videoRepo:       videoRepo,
+		colorRepo:       colorRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+		storageURL:      strings.TrimSuffix(storageURL, "/"),
+		signingKey:      signingKey,
+	}
+}
+
+func (uc *framesUseCaseImpl) Execute(ctx context.Context, cmd commands.FramesCommand) (*FramesOutput, error) {
+	if cmd.Color != "" && !hexColorPattern.MatchString(cmd.Color) {
+		return nil, errors.New("color must be given as #rrggbb")
+	}
+
+	if cmd.Tolerance < 0 || cmd.Tolerance > maxColorTolerance {
+		return nil, fmt.Errorf("tolerance must be between 0 and %d", maxColorTolerance)
+	}
+
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	frames, err := loadFrames(ctx, uc.s3Client, uc.processedBucket, video)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make(map[int]*entities.FrameColor)
+	if cmd.Color != "" {
+		rgb, _ := strconv.ParseUint(cmd.Color[1:], 16, 32)
+		r, g, b := int(rgb>>16), int(rgb>>8&0xff), int(rgb&0xff)
+
+		colors, err := uc.colorRepo.FindByColor(ctx, video.ID, r, g, b, cmd.Tolerance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search frame colors: %w", err)
+		}
+
+		// Colors come ordered by frame and rank, so the first match of each
+		// frame is its most prominent color within tolerance.
+		for _, c := range colors {
+			if _, ok := matches[c.FrameIndex]; !ok {
+				matches[c.FrameIndex] = c
+			}
+		}
+
+		filtered := frames[:0]
+		for _, f := range frames {
+			if _, ok := matches[f.Index]; ok {
+				filtered = append(filtered, f)
+			}
+		}
+		frames = filtered
+	}
+
+	total := len(frames)
+	page := frames[min(cmd.Offset, total):min(cmd.Offset+cmd.Limit, total)]
+
+	output := &FramesOutput{
+		VideoID:   video.ID,
+		Frames:    make([]Frame, len(page)),
+		Total:     total,
+		Limit:     cmd.Limit,
+		Offset:    cmd.Offset,
+		HasMore:   cmd.Offset+cmd.Limit < total,
+		ExpiresIn: int64(presignedURLExpiry.Seconds()),
+	}
+
+	expires := time.Now().Add(presignedURLExpiry)
+	for i, f := range page {
+		frameURL, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, framesPrefix(video)+f.Filename, presignedURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate frame URL: %w", err)
+		}
+
+		output.Frames[i] = Frame{
+			Index:    f.Index,
+			Filename: f.Filename,
+			PTS:      f.PTS,
+			Timecode: f.Timecode,
+			URL:      frameURL,
+		}
+		if uc.signingKey != "" {
+			output.Frames[i].ThumbnailURL = uc.thumbnailURL(video, f.Filename, expires)
+		}
+		if match, ok := matches[f.Index]; ok {
+			output.Frames[i].Color = match.Hex
+			output.Frames[i].Weight = match.Weight
+		}
+	}
+
+	return output, nil
+}
+
+// thumbnailURL signs a storage service transform URL for a downscaled copy
+// of the frame, valid as long as the presigned originals.
+func (uc *framesUseCaseImpl) thumbnailURL(video *entities.Video, filename string, expires time.Time) string {
+	query := url.Values{"width": {strconv.Itoa(thumbnailWidth)}}
+	return transformURL(uc.storageURL, uc.signingKey, video, filename, query, expires)
+}
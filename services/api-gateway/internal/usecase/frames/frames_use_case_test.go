@@ -0,0 +1,443 @@
+package frames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+	"github.com/video-platform/shared/pkg/urlsign"
+)
+
+type MockVideoRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoRepository) Create(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entities.Video, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
+func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+type MockFrameColorRepository struct {
+	mock.Mock
+}
+
+func (m *MockFrameColorRepository) FindByColor(ctx context.Context, videoID uuid.UUID, r, g, b, tolerance int) ([]*entities.FrameColor, error) {
+	args := m.Called(ctx, videoID, r, g, b, tolerance)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.FrameColor), args.Error(1)
+}
+
+// testManifest returns a manifest of count frames extracted at 1 fps from a
+// source that starts at 0.5 seconds.
+func testManifest(count int) io.ReadCloser {
+	var entries []string
+	for i := 1; i <= count; i++ {
+		entries = append(entries, fmt.Sprintf(`{"index":%d,"filename":"frame_%04d.jpg","pts":%.1f,"timecode":"00:00:%02d:12","size":100}`, i, i, float64(i)-0.5, i-1))
+	}
+	return io.NopCloser(strings.NewReader(`{"extraction_fps":1,"frames":[` + strings.Join(entries, ",") + `]}`))
+}
+
+func TestFramesUseCase_Execute_Paginated(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FramesCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Limit:   2,
+		Offset:  4,
+	}
+
+	prefix := "processed/" + videoID.String() + "/frames/"
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(10), nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"frame_0005.jpg", 15*time.Minute).Return("https://s3.example.com/frame_0005", nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"frame_0006.jpg", 15*time.Minute).Return("https://s3.example.com/frame_0006", nil)
+
+	useCase := NewFramesUseCase(mockRepo, mockColorRepo, mockS3, "processed-bucket", "", "")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 10, result.Total)
+	assert.True(t, result.HasMore)
+	assert.Len(t, result.Frames, 2)
+	assert.Equal(t, 5, result.Frames[0].Index)
+	assert.Equal(t, 4.5, result.Frames[0].PTS)
+	assert.Equal(t, "https://s3.example.com/frame_0006", result.Frames[1].URL)
+	assert.Empty(t, result.Frames[0].Color)
+
+	mockS3.AssertExpectations(t)
+	mockColorRepo.AssertNotCalled(t, "FindByColor", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFramesUseCase_Execute_SignsThumbnailURLs(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FramesCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Limit:   1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(1), nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", mock.AnythingOfType("string"), 15*time.Minute).Return("https://s3.example.com/frame", nil)
+
+	useCase := NewFramesUseCase(mockRepo, mockColorRepo, mockS3, "processed-bucket", "http://storage.example.com/", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Frames, 1)
+
+	thumbnail, err := url.Parse(result.Frames[0].ThumbnailURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "storage.example.com", thumbnail.Host)
+	assert.Equal(t, "/frames/"+videoID.String()+"/frame_0001.jpg", thumbnail.Path)
+	assert.Equal(t, "320", thumbnail.Query().Get("width"))
+	assert.NoError(t, urlsign.Verify("secret", thumbnail.Path, thumbnail.Query(), time.Now()))
+	assert.Error(t, urlsign.Verify("other", thumbnail.Path, thumbnail.Query(), time.Now()))
+}
+
+func TestFramesUseCase_Execute_WithoutManifest(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		FPS:    2,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FramesCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Limit:   20,
+	}
+
+	prefix := "processed/" + videoID.String() + "/frames/"
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(nil, errors.New("NoSuchKey"))
+	mockS3.On("ListObjects", ctx, "processed-bucket", prefix).
+		Return([]string{prefix + "frame_0002.jpg", prefix + "frame_0001.jpg"}, nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", mock.AnythingOfType("string"), 15*time.Minute).Return("https://s3.example.com/frame", nil)
+
+	useCase := NewFramesUseCase(mockRepo, mockColorRepo, mockS3, "processed-bucket", "", "")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.False(t, result.HasMore)
+	assert.Equal(t, "frame_0001.jpg", result.Frames[0].Filename)
+	assert.Equal(t, 0.5, result.Frames[1].PTS)
+}
+
+func TestFramesUseCase_Execute_ColorSearch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FramesCommand{
+		VideoID:   videoID,
+		UserID:    1,
+		Color:     "#FF8000",
+		Tolerance: 40,
+		Limit:     20,
+	}
+
+	matches := []*entities.FrameColor{
+		{VideoID: videoID, FrameIndex: 3, Rank: 1, Hex: "#f07a05", Weight: 0.4},
+		{VideoID: videoID, FrameIndex: 3, Rank: 4, Hex: "#ff8811", Weight: 0.05},
+		{VideoID: videoID, FrameIndex: 7, Rank: 2, Hex: "#ff8000", Weight: 0.2},
+	}
+
+	prefix := "processed/" + videoID.String() + "/frames/"
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/manifest.json").
+		Return(testManifest(10), nil)
+	mockColorRepo.On("FindByColor", ctx, videoID, 255, 128, 0, 40).Return(matches, nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"frame_0003.jpg", 15*time.Minute).Return("https://s3.example.com/frame_0003", nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"frame_0007.jpg", 15*time.Minute).Return("https://s3.example.com/frame_0007", nil)
+
+	useCase := NewFramesUseCase(mockRepo, mockColorRepo, mockS3, "processed-bucket", "", "")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Frames, 2)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, Frame{
+		Index:    3,
+		Filename: "frame_0003.jpg",
+		PTS:      2.5,
+		Timecode: "00:00:02:12",
+		URL:      "https://s3.example.com/frame_0003",
+		Color:    "#f07a05",
+		Weight:   0.4,
+	}, result.Frames[0])
+	assert.Equal(t, 7, result.Frames[1].Index)
+
+	mockColorRepo.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+}
+
+func TestFramesUseCase_Execute_InvalidColor(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockS3 := new(MockS3Client)
+
+	cmd := commands.FramesCommand{
+		VideoID: uuid.New(),
+		UserID:  1,
+		Color:   "red",
+	}
+
+	useCase := NewFramesUseCase(mockRepo, mockColorRepo, mockS3, "processed-bucket", "", "")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestFramesUseCase_Execute_AccessDenied(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 2,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.FramesCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Color:   "#ff0000",
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewFramesUseCase(mockRepo, mockColorRepo, mockS3, "processed-bucket", "", "")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "access denied", err.Error())
+	mockColorRepo.AssertNotCalled(t, "FindByColor", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
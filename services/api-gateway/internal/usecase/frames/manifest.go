@@ -0,0 +1,75 @@
+package frames
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+// manifestFrame is the part of a manifest entry the frame API exposes.
+type manifestFrame struct {
+	Index    int     `json:"index"`
+	Filename string  `json:"filename"`
+	PTS      float64 `json:"pts"`
+	Timecode string  `json:"timecode"`
+}
+
+type frameManifest struct {
+	Frames []manifestFrame `json:"frames"`
+}
+
+func framesPrefix(video *entities.Video) string {
+	return fmt.Sprintf("processed/%s/frames/", video.ID)
+}
+
+// loadFrames returns the uploaded frames of a video ordered by index. They
+// come from the manifest written by the worker; videos processed before the
+// manifest existed fall back to listing the frames prefix, deriving the
+// timestamps from the extraction rate.
+func loadFrames(ctx context.Context, s3Client s3.S3Client, bucket string, video *entities.Video) ([]manifestFrame, error) {
+	reader, err := s3Client.GetObject(ctx, bucket, fmt.Sprintf("processed/%s/manifest.json", video.ID))
+	if err == nil {
+		defer reader.Close()
+
+		var manifest frameManifest
+		if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+			return nil, fmt.Errorf("failed to read frame manifest: %w", err)
+		}
+		return manifest.Frames, nil
+	}
+
+	keys, err := s3Client.ListObjects(ctx, bucket, framesPrefix(video))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list frames: %w", err)
+	}
+
+	fps := video.FPS
+	if fps <= 0 {
+		fps = 1
+	}
+
+	frames := make([]manifestFrame, 0, len(keys))
+	for _, key := range keys {
+		name := path.Base(key)
+		number, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "frame_"), path.Ext(name)))
+		if err != nil || !strings.HasPrefix(name, "frame_") {
+			continue
+		}
+
+		frames = append(frames, manifestFrame{
+			Index:    number,
+			Filename: name,
+			PTS:      float64(number-1) / float64(fps),
+		})
+	}
+
+	sort.Slice(frames, func(i, j int) bool { return frames[i].Index < frames[j].Index })
+	return frames, nil
+}
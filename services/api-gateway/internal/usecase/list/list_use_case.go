@@ -15,6 +15,7 @@ type VideoInfo struct {
 	FrameCount  *int       `json:"frame_count"`
 	CreatedAt   time.Time  `json:"created_at"`
 	CompletedAt *time.Time `json:"completed_at"`
+	PreviewURL  *string    `json:"preview_url,omitempty"`
 }
 
 type ListOutput struct {
@@ -2,18 +2,31 @@ package list
 
 import (
 	"context"
+	"time"
 
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
+const previewURLExpiry = 1 * time.Hour
+
 type listUseCaseImpl struct {
-	videoRepo repositories.VideoRepository
+	videoRepo       repositories.VideoRepository
+	s3Client        s3.S3Client
+	processedBucket string
 }
 
-func NewListUseCase(videoRepo repositories.VideoRepository) ListUseCase {
+func NewListUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) ListUseCase {
 	return &listUseCaseImpl{
-		videoRepo: videoRepo,
+		videoRepo:       videoRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
 	}
 }
 
@@ -38,6 +51,14 @@ func (uc *listUseCaseImpl) Execute(ctx context.Context, cmd commands.ListCommand
 			CreatedAt:   v.CreatedAt,
 			CompletedAt: v.CompletedAt,
 		}
+
+		if v.Status == entities.StatusCompleted && v.PreviewPath != nil {
+			url, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, *v.PreviewPath, previewURLExpiry)
+			if err != nil {
+				return nil, err
+			}
+			videoInfos[i].PreviewURL = &url
+		}
 	}
 
 	return &ListOutput{
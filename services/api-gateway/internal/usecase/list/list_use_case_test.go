@@ -3,6 +3,7 @@ package list
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
 type MockVideoRepository struct {
@@ -43,15 +45,132 @@ func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
 func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
 	args := m.Called(ctx, id, status)
 	return args.Error(0)
 }
 
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
 func TestListUseCase_Execute_Success(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
 
 	videos := []*entities.Video{
 		{
@@ -77,7 +196,7 @@ func TestListUseCase_Execute_Success(t *testing.T) {
 	mockRepo.On("FindByUserID", ctx, int64(1), 10, 0).Return(videos, nil)
 	mockRepo.On("CountByUserID", ctx, int64(1)).Return(int64(2), nil)
 
-	useCase := NewListUseCase(mockRepo)
+	useCase := NewListUseCase(mockRepo, mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -98,6 +217,7 @@ func TestListUseCase_Execute_EmptyList(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
 
 	cmd := commands.ListCommand{
 		UserID: 1,
@@ -108,7 +228,7 @@ func TestListUseCase_Execute_EmptyList(t *testing.T) {
 	mockRepo.On("FindByUserID", ctx, int64(1), 10, 0).Return([]*entities.Video{}, nil)
 	mockRepo.On("CountByUserID", ctx, int64(1)).Return(int64(0), nil)
 
-	useCase := NewListUseCase(mockRepo)
+	useCase := NewListUseCase(mockRepo, mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -127,6 +247,7 @@ func TestListUseCase_Execute_Pagination(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
 
 	videos := []*entities.Video{
 		{ID: uuid.New(), Filename: "video3.mp4"},
@@ -142,7 +263,7 @@ func TestListUseCase_Execute_Pagination(t *testing.T) {
 	mockRepo.On("FindByUserID", ctx, int64(1), 2, 2).Return(videos, nil)
 	mockRepo.On("CountByUserID", ctx, int64(1)).Return(int64(5), nil)
 
-	useCase := NewListUseCase(mockRepo)
+	useCase := NewListUseCase(mockRepo, mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -163,6 +284,7 @@ func TestListUseCase_Execute_FindError(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
 
 	cmd := commands.ListCommand{
 		UserID: 1,
@@ -172,7 +294,7 @@ func TestListUseCase_Execute_FindError(t *testing.T) {
 
 	mockRepo.On("FindByUserID", ctx, int64(1), 10, 0).Return(nil, errors.New("database error"))
 
-	useCase := NewListUseCase(mockRepo)
+	useCase := NewListUseCase(mockRepo, mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -189,6 +311,7 @@ func TestListUseCase_Execute_CountError(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
 
 	videos := []*entities.Video{{ID: uuid.New(), Filename: "video1.mp4"}}
 
@@ -201,7 +324,7 @@ func TestListUseCase_Execute_CountError(t *testing.T) {
 	mockRepo.On("FindByUserID", ctx, int64(1), 10, 0).Return(videos, nil)
 	mockRepo.On("CountByUserID", ctx, int64(1)).Return(int64(0), errors.New("count error"))
 
-	useCase := NewListUseCase(mockRepo)
+	useCase := NewListUseCase(mockRepo, mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -213,3 +336,50 @@ func TestListUseCase_Execute_CountError(t *testing.T) {
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestListUseCase_Execute_IncludesPreviewURL(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	previewPath := "processed/abc/preview.gif"
+	videos := []*entities.Video{
+		{
+			ID:          uuid.New(),
+			Filename:    "video1.mp4",
+			Status:      entities.StatusCompleted,
+			PreviewPath: &previewPath,
+		},
+		{
+			ID:       uuid.New(),
+			Filename: "video2.mp4",
+			Status:   entities.StatusProcessing,
+		},
+	}
+
+	cmd := commands.ListCommand{
+		UserID: 1,
+		Limit:  10,
+		Offset: 0,
+	}
+
+	mockRepo.On("FindByUserID", ctx, int64(1), 10, 0).Return(videos, nil)
+	mockRepo.On("CountByUserID", ctx, int64(1)).Return(int64(2), nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", previewPath, time.Hour).Return("https://s3.example.com/preview.gif", nil)
+
+	useCase := NewListUseCase(mockRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Videos, 2)
+	assert.NotNil(t, result.Videos[0].PreviewURL)
+	assert.Equal(t, "https://s3.example.com/preview.gif", *result.Videos[0].PreviewURL)
+	assert.Nil(t, result.Videos[1].PreviewURL)
+
+	mockRepo.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+}
@@ -0,0 +1,27 @@
+package playback
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type PlaybackOutput struct {
+	VideoID   uuid.UUID
+	URL       string
+	ExpiresIn int64
+}
+
+type PlaybackUseCase interface {
+	Execute(ctx context.Context, cmd commands.PlaybackCommand) (*PlaybackOutput, error)
+}
+
+type PlaylistOutput struct {
+	Playlist  string
+	ExpiresIn int64
+}
+
+type PlaylistUseCase interface {
+	Execute(ctx context.Context, cmd commands.PlaylistCommand) (*PlaylistOutput, error)
+}
@@ -0,0 +1,73 @@
+package playback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/urlsign"
+)
+
+// Playlists are fetched once per viewing session, so their links need to
+// outlive the usual download expiry.
+const playbackExpiry = 2 * time.Hour
+
+const masterPlaylist = "master.m3u8"
+
+type playbackUseCaseImpl struct {
+	videoRepo  repositories.VideoRepository
+	apiURL     string
+	signingKey string
+}
+
+func NewPlaybackUseCase(
+	videoRepo repositories.VideoRepository,
+	apiURL string,
+	signingKey string,
+) PlaybackUseCase {
+	return &playbackUseCaseImpl{
+		videoRepo:  videoRepo,
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		signingKey: signingKey,
+	}
+}
+
+func (uc *playbackUseCaseImpl) Execute(ctx context.Context, cmd commands.PlaybackCommand) (*PlaybackOutput, error) {
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	if video.HLSPath == nil {
+		return nil, errors.New("HLS playback not available")
+	}
+
+	path := playlistPath(video.ID, masterPlaylist)
+	expires := time.Now().Add(playbackExpiry)
+
+	return &PlaybackOutput{
+		VideoID:   video.ID,
+		URL:       uc.apiURL + path + "?" + urlsign.Sign(uc.signingKey, path, nil, expires),
+		ExpiresIn: int64(playbackExpiry.Seconds()),
+	}, nil
+}
+
+// playlistPath is the route a playlist is served from, relative to the API
+// base URL. Signatures cover this path so they survive the route prefix.
+func playlistPath(videoID uuid.UUID, name string) string {
+	return fmt.Sprintf("/videos/%s/hls/%s", videoID, name)
+}
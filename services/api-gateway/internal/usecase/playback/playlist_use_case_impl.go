@@ -0,0 +1,104 @@
+package playback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+	"github.com/video-platform/shared/pkg/urlsign"
+)
+
+var mediaPlaylistPattern = regexp.MustCompile(`^\d+p/index\.m3u8$`)
+
+type playlistUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	s3Client        s3.S3Client
+	processedBucket string
+	signingKey      string
+}
+
+func NewPlaylistUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+	signingKey string,
+) PlaylistUseCase {
+	return &playlistUseCaseImpl{
+		videoRepo:       videoRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+		signingKey:      signingKey,
+	}
+}
+
+// Execute serves a playlist with every URI it references made fetchable
+// without a bearer token: the master's media playlists get signed gateway
+// links sharing the master's expiry, and media playlists' segments get
+// presigned S3 URLs.
+func (uc *playlistUseCaseImpl) Execute(ctx context.Context, cmd commands.PlaylistCommand) (*PlaylistOutput, error) {
+	if cmd.Name != masterPlaylist && !mediaPlaylistPattern.MatchString(cmd.Name) {
+		return nil, errors.New("playlist not found")
+	}
+
+	if err := urlsign.Verify(uc.signingKey, playlistPath(cmd.VideoID, cmd.Name), cmd.Query, time.Now()); err != nil {
+		return nil, err
+	}
+
+	expires, err := urlsign.Expires(cmd.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.HLSPath == nil {
+		return nil, errors.New("HLS playback not available")
+	}
+
+	prefix := fmt.Sprintf("processed/%s/hls/", video.ID)
+	reader, err := uc.s3Client.GetObject(ctx, uc.processedBucket, prefix+cmd.Name)
+	if err != nil {
+		return nil, errors.New("playlist not found")
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read playlist: %w", err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		uri := strings.TrimSpace(line)
+		if uri == "" || strings.HasPrefix(uri, "#") {
+			continue
+		}
+
+		if cmd.Name == masterPlaylist {
+			lines[i] = uri + "?" + urlsign.Sign(uc.signingKey, playlistPath(video.ID, uri), nil, expires)
+			continue
+		}
+
+		rendition, _, _ := strings.Cut(cmd.Name, "/")
+		url, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, prefix+rendition+"/"+uri, playbackExpiry)
+		if err != nil {
+			return nil, err
+		}
+		lines[i] = url
+	}
+
+	return &PlaylistOutput{
+		Playlist:  strings.Join(lines, "\n"),
+		ExpiresIn: int64(time.Until(expires).Seconds()),
+	}, nil
+}
@@ -0,0 +1,177 @@
+package playback
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/urlsign"
+)
+
+func hlsVideo(videoID uuid.UUID) *entities.Video {
+	hlsPath := "processed/" + videoID.String() + "/hls/master.m3u8"
+	return &entities.Video{
+		ID:      videoID,
+		UserID:  1,
+		Status:  entities.StatusCompleted,
+		HLSPath: &hlsPath,
+	}
+}
+
+func signedQuery(t *testing.T, videoID uuid.UUID, name string, expires time.Time) url.Values {
+	query, err := url.ParseQuery(urlsign.Sign("secret", "/videos/"+videoID.String()+"/hls/"+name, nil, expires))
+	assert.NoError(t, err)
+	return query
+}
+
+func TestPlaylistUseCase_Execute_SignsMediaPlaylists(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	expires := time.Now().Add(time.Hour)
+	master := "#EXTM3U\n#EXT-X-VERSION:3\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=928000,RESOLUTION=640x360\n360p/index.m3u8\n"
+
+	mockRepo.On("FindByID", ctx, videoID).Return(hlsVideo(videoID), nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", "processed/"+videoID.String()+"/hls/master.m3u8").
+		Return(io.NopCloser(strings.NewReader(master)), nil)
+
+	useCase := NewPlaylistUseCase(mockRepo, mockS3, "processed-bucket", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.PlaylistCommand{
+		VideoID: videoID,
+		Name:    "master.m3u8",
+		Query:   signedQuery(t, videoID, "master.m3u8", expires),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	lines := strings.Split(result.Playlist, "\n")
+	assert.Equal(t, "#EXT-X-STREAM-INF:BANDWIDTH=928000,RESOLUTION=640x360", lines[2])
+
+	uri, rawQuery, found := strings.Cut(lines[3], "?")
+	assert.True(t, found)
+	assert.Equal(t, "360p/index.m3u8", uri)
+
+	// The media playlist link expires with the master.
+	query, err := url.ParseQuery(rawQuery)
+	assert.NoError(t, err)
+	assert.NoError(t, urlsign.Verify("secret", "/videos/"+videoID.String()+"/hls/360p/index.m3u8", query, time.Now()))
+	linkExpires, err := urlsign.Expires(query)
+	assert.NoError(t, err)
+	assert.Equal(t, expires.Unix(), linkExpires.Unix())
+	mockS3.AssertNotCalled(t, "GeneratePresignedURL")
+}
+
+func TestPlaylistUseCase_Execute_PresignsSegments(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	prefix := "processed/" + videoID.String() + "/hls/720p/"
+	media := "#EXTM3U\n#EXT-X-TARGETDURATION:6\n" +
+		"#EXTINF:6.000000,\nsegment_0000.ts\n" +
+		"#EXTINF:2.500000,\nsegment_0001.ts\n#EXT-X-ENDLIST\n"
+
+	mockRepo.On("FindByID", ctx, videoID).Return(hlsVideo(videoID), nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", prefix+"index.m3u8").Return(io.NopCloser(strings.NewReader(media)), nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"segment_0000.ts", 2*time.Hour).Return("https://s3.example.com/segment_0000", nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", prefix+"segment_0001.ts", 2*time.Hour).Return("https://s3.example.com/segment_0001", nil)
+
+	useCase := NewPlaylistUseCase(mockRepo, mockS3, "processed-bucket", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.PlaylistCommand{
+		VideoID: videoID,
+		Name:    "720p/index.m3u8",
+		Query:   signedQuery(t, videoID, "720p/index.m3u8", time.Now().Add(time.Hour)),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "#EXTM3U\n#EXT-X-TARGETDURATION:6\n"+
+		"#EXTINF:6.000000,\nhttps://s3.example.com/segment_0000\n"+
+		"#EXTINF:2.500000,\nhttps://s3.example.com/segment_0001\n#EXT-X-ENDLIST\n", result.Playlist)
+	mockS3.AssertExpectations(t)
+}
+
+func TestPlaylistUseCase_Execute_InvalidSignature(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+
+	useCase := NewPlaylistUseCase(mockRepo, mockS3, "processed-bucket", "secret")
+
+	// Act: a master signature does not cover a media playlist.
+	result, err := useCase.Execute(ctx, commands.PlaylistCommand{
+		VideoID: videoID,
+		Name:    "720p/index.m3u8",
+		Query:   signedQuery(t, videoID, "master.m3u8", time.Now().Add(time.Hour)),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, urlsign.ErrInvalidSignature)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "FindByID")
+}
+
+func TestPlaylistUseCase_Execute_Expired(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+
+	useCase := NewPlaylistUseCase(mockRepo, mockS3, "processed-bucket", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.PlaylistCommand{
+		VideoID: videoID,
+		Name:    "master.m3u8",
+		Query:   signedQuery(t, videoID, "master.m3u8", time.Now().Add(-time.Minute)),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, urlsign.ErrExpired)
+	assert.Nil(t, result)
+}
+
+func TestPlaylistUseCase_Execute_UnknownPlaylist(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+
+	useCase := NewPlaylistUseCase(mockRepo, mockS3, "processed-bucket", "secret")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.PlaylistCommand{
+		VideoID: videoID,
+		Name:    "../frames/frame_0001.jpg",
+		Query:   signedQuery(t, videoID, "../frames/frame_0001.jpg", time.Now().Add(time.Hour)),
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "playlist not found", err.Error())
+}
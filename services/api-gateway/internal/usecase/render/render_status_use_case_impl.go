@@ -0,0 +1,65 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const presignedURLExpiry = 15 * time.Minute
+
+type renderStatusUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	renderRepo      repositories.RenderRepository
+	s3Client        s3.S3Client
+	processedBucket string
+}
+
+func NewRenderStatusUseCase(
+	videoRepo repositories.VideoRepository,
+	renderRepo repositories.RenderRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) RenderStatusUseCase {
+	return &renderStatusUseCaseImpl{
+		videoRepo:       videoRepo,
+		renderRepo:      renderRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+	}
+}
+
+func (uc *renderStatusUseCaseImpl) Execute(ctx context.Context, cmd commands.RenderStatusCommand) (*RenderOutput, error) {
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	render, err := uc.renderRepo.FindByID(ctx, cmd.RenderID)
+	if err != nil || render.VideoID != video.ID {
+		return nil, errors.New("render not found")
+	}
+
+	output := presentRender(render)
+	if render.Status != entities.StatusCompleted || render.OutputPath == nil {
+		return output, nil
+	}
+
+	url, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, *render.OutputPath, presignedURLExpiry)
+	if err != nil {
+		return nil, err
+	}
+	output.URL = &url
+	output.ExpiresIn = int64(presignedURLExpiry.Seconds())
+
+	return output, nil
+}
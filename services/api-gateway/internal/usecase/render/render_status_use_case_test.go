@@ -0,0 +1,122 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+func TestRenderStatusUseCase_Execute_Completed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockRenderRepo := new(MockRenderRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	renderID := uuid.New()
+	outputPath := "processed/" + videoID.String() + "/renders/" + renderID.String() + ".mp4"
+	frameCount := 30
+
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 1}, nil)
+	mockRenderRepo.On("FindByID", ctx, renderID).Return(&entities.Render{
+		ID:         renderID,
+		VideoID:    videoID,
+		Format:     "mp4",
+		FPS:        24,
+		Status:     entities.StatusCompleted,
+		FrameCount: &frameCount,
+		OutputPath: &outputPath,
+	}, nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", outputPath, 15*time.Minute).Return("https://s3.example.com/render", nil)
+
+	useCase := NewRenderStatusUseCase(mockRepo, mockRenderRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.RenderStatusCommand{VideoID: videoID, RenderID: renderID, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLETED", result.Status)
+	assert.Equal(t, 30, *result.FrameCount)
+	assert.Equal(t, "https://s3.example.com/render", *result.URL)
+	assert.Equal(t, int64(900), result.ExpiresIn)
+}
+
+func TestRenderStatusUseCase_Execute_Pending(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockRenderRepo := new(MockRenderRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	renderID := uuid.New()
+
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 1}, nil)
+	mockRenderRepo.On("FindByID", ctx, renderID).Return(&entities.Render{
+		ID:      renderID,
+		VideoID: videoID,
+		Status:  entities.StatusPending,
+	}, nil)
+
+	useCase := NewRenderStatusUseCase(mockRepo, mockRenderRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.RenderStatusCommand{VideoID: videoID, RenderID: renderID, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING", result.Status)
+	assert.Nil(t, result.URL)
+	mockS3.AssertNotCalled(t, "GeneratePresignedURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRenderStatusUseCase_Execute_RenderOfOtherVideo(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockRenderRepo := new(MockRenderRepository)
+
+	videoID := uuid.New()
+	renderID := uuid.New()
+
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, UserID: 1}, nil)
+	mockRenderRepo.On("FindByID", ctx, renderID).Return(&entities.Render{ID: renderID, VideoID: uuid.New()}, nil)
+
+	useCase := NewRenderStatusUseCase(mockRepo, mockRenderRepo, new(MockS3Client), "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.RenderStatusCommand{VideoID: videoID, RenderID: renderID, UserID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "render not found")
+}
+
+func TestRenderStatusUseCase_Execute_VideoNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockRenderRepo := new(MockRenderRepository)
+
+	videoID := uuid.New()
+	mockRepo.On("FindByID", ctx, videoID).Return(nil, errors.New("video not found"))
+
+	useCase := NewRenderStatusUseCase(mockRepo, mockRenderRepo, new(MockS3Client), "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.RenderStatusCommand{VideoID: videoID, RenderID: uuid.New(), UserID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "video not found")
+	mockRenderRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,34 @@
+package render
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type RenderOutput struct {
+	ID           uuid.UUID  `json:"id"`
+	VideoID      uuid.UUID  `json:"video_id"`
+	Status       string     `json:"status"`
+	Format       string     `json:"format"`
+	FPS          float64    `json:"fps"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	FrameCount   *int       `json:"frame_count"`
+	URL          *string    `json:"url,omitempty"`
+	ExpiresIn    int64      `json:"expires_in,omitempty"`
+	ErrorMessage *string    `json:"error_message"`
+	CreatedAt    time.Time  `json:"created_at"`
+	StartedAt    *time.Time `json:"started_at"`
+	CompletedAt  *time.Time `json:"completed_at"`
+}
+
+type RenderUseCase interface {
+	Execute(ctx context.Context, cmd commands.RenderCommand) (*RenderOutput, error)
+}
+
+type RenderStatusUseCase interface {
+	Execute(ctx context.Context, cmd commands.RenderStatusCommand) (*RenderOutput, error)
+}
@@ -0,0 +1,153 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+)
+
+const (
+	defaultRenderFormat = "mp4"
+	defaultRenderFPS    = 24
+
+	maxRenderFPS       = 60
+	maxRenderDimension = 3840
+	maxRenderFrames    = 10000
+)
+
+var allowedRenderFormats = map[string]bool{
+	"mp4":  true,
+	"webm": true,
+}
+
+type renderUseCaseImpl struct {
+	videoRepo  repositories.VideoRepository
+	renderRepo repositories.RenderRepository
+	publisher  rabbitmq.Publisher
+}
+
+func NewRenderUseCase(
+	videoRepo repositories.VideoRepository,
+	renderRepo repositories.RenderRepository,
+	publisher rabbitmq.Publisher,
+) RenderUseCase {
+	return &renderUseCaseImpl{
+		videoRepo:  videoRepo,
+		renderRepo: renderRepo,
+		publisher:  publisher,
+	}
+}
+
+func (uc *renderUseCaseImpl) Execute(ctx context.Context, cmd commands.RenderCommand) (*RenderOutput, error) {
+	if cmd.Format == "" {
+		cmd.Format = defaultRenderFormat
+	}
+	if cmd.FPS == 0 {
+		cmd.FPS = defaultRenderFPS
+	}
+
+	if err := validateRender(cmd); err != nil {
+		return nil, err
+	}
+
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	render := &entities.Render{
+		ID:        uuid.New(),
+		VideoID:   video.ID,
+		UserID:    cmd.UserID,
+		Format:    cmd.Format,
+		FPS:       cmd.FPS,
+		Width:     cmd.Width,
+		Height:    cmd.Height,
+		Status:    entities.StatusPending,
+		CreatedAt: time.Now(),
+	}
+
+	if err := uc.renderRepo.Create(ctx, render); err != nil {
+		return nil, fmt.Errorf("failed to create render record: %w", err)
+	}
+
+	jobMessage := map[string]interface{}{
+		"render_id": render.ID.String(),
+		"video_id":  video.ID.String(),
+		"user_id":   cmd.UserID,
+		"frames":    cmd.Frames,
+		"format":    cmd.Format,
+		"fps":       cmd.FPS,
+		"width":     cmd.Width,
+		"height":    cmd.Height,
+	}
+
+	if err := uc.publisher.Publish(ctx, "video.render.queue", jobMessage); err != nil {
+		return nil, fmt.Errorf("failed to queue render job: %w", err)
+	}
+
+	return presentRender(render), nil
+}
+
+func validateRender(cmd commands.RenderCommand) error {
+	if !allowedRenderFormats[cmd.Format] {
+		return errors.New("render format must be mp4 or webm")
+	}
+
+	if cmd.FPS <= 0 || cmd.FPS > maxRenderFPS {
+		return fmt.Errorf("render fps must be between 0 and %d", maxRenderFPS)
+	}
+
+	if cmd.Width < 0 || cmd.Width > maxRenderDimension || cmd.Height < 0 || cmd.Height > maxRenderDimension {
+		return fmt.Errorf("render width and height must be between 0 and %d", maxRenderDimension)
+	}
+
+	// Both encoders subsample chroma, which needs even dimensions.
+	if cmd.Width%2 != 0 || cmd.Height%2 != 0 {
+		return errors.New("render width and height must be even")
+	}
+
+	if len(cmd.Frames) > maxRenderFrames {
+		return fmt.Errorf("a render may use at most %d frames", maxRenderFrames)
+	}
+
+	for _, index := range cmd.Frames {
+		if index < 1 {
+			return errors.New("frame indexes start at 1")
+		}
+	}
+
+	return nil
+}
+
+func presentRender(render *entities.Render) *RenderOutput {
+	return &RenderOutput{
+		ID:           render.ID,
+		VideoID:      render.VideoID,
+		Status:       string(render.Status),
+		Format:       render.Format,
+		FPS:          render.FPS,
+		Width:        render.Width,
+		Height:       render.Height,
+		FrameCount:   render.FrameCount,
+		ErrorMessage: render.ErrorMessage,
+		CreatedAt:    render.CreatedAt,
+		StartedAt:    render.StartedAt,
+		CompletedAt:  render.CompletedAt,
+	}
+}
@@ -0,0 +1,330 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockVideoRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoRepository) Create(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entities.Video, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
+func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+type MockRenderRepository struct {
+	mock.Mock
+}
+
+func (m *MockRenderRepository) Create(ctx context.Context, render *entities.Render) error {
+	args := m.Called(ctx, render)
+	return args.Error(0)
+}
+
+func (m *MockRenderRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Render, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Render), args.Error(1)
+}
+
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, queue string, message interface{}) error {
+	args := m.Called(ctx, queue, message)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) PublishWithOptions(ctx context.Context, queue string, message interface{}, opts rabbitmq.PublishOptions) error {
+	args := m.Called(ctx, queue, message, opts)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func TestRenderUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockRenderRepo := new(MockRenderRepository)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	cmd := commands.RenderCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Frames:  []int{3, 1, 2},
+		Width:   640,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockRenderRepo.On("Create", ctx, mock.MatchedBy(func(r *entities.Render) bool {
+		return r.VideoID == videoID && r.Format == "mp4" && r.FPS == 24 && r.Status == entities.StatusPending
+	})).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.render.queue", mock.MatchedBy(func(msg map[string]interface{}) bool {
+		return msg["video_id"] == videoID.String() && assert.ObjectsAreEqual([]int{3, 1, 2}, msg["frames"])
+	})).Return(nil)
+
+	useCase := NewRenderUseCase(mockRepo, mockRenderRepo, mockPublisher)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, videoID, result.VideoID)
+	assert.Equal(t, "PENDING", result.Status)
+	assert.Equal(t, "mp4", result.Format)
+	assert.Equal(t, 640, result.Width)
+
+	mockRenderRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestRenderUseCase_Execute_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  commands.RenderCommand
+		err  string
+	}{
+		{"format", commands.RenderCommand{Format: "avi"}, "render format must be mp4 or webm"},
+		{"fps", commands.RenderCommand{FPS: 120}, "render fps must be between 0 and 60"},
+		{"size", commands.RenderCommand{Width: 8000}, "render width and height must be between 0 and 3840"},
+		{"odd size", commands.RenderCommand{Width: 641}, "render width and height must be even"},
+		{"frame index", commands.RenderCommand{Frames: []int{0}}, "frame indexes start at 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockVideoRepository)
+			useCase := NewRenderUseCase(mockRepo, new(MockRenderRepository), new(MockPublisher))
+
+			result, err := useCase.Execute(context.Background(), tt.cmd)
+
+			assert.Nil(t, result)
+			assert.EqualError(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestRenderUseCase_Execute_NotCompleted(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockRenderRepo := new(MockRenderRepository)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusProcessing,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewRenderUseCase(mockRepo, mockRenderRepo, new(MockPublisher))
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.RenderCommand{VideoID: videoID, UserID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "video processing not completed")
+	mockRenderRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRenderUseCase_Execute_PublishError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockRenderRepo := new(MockRenderRepository)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:     videoID,
+		UserID: 1,
+		Status: entities.StatusCompleted,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockRenderRepo.On("Create", ctx, mock.Anything).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.render.queue", mock.Anything).Return(errors.New("broker down"))
+
+	useCase := NewRenderUseCase(mockRepo, mockRenderRepo, mockPublisher)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.RenderCommand{VideoID: videoID, UserID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failed to queue render job")
+}
@@ -0,0 +1,33 @@
+package shots
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type Shot struct {
+	Index         int     `json:"index"`
+	StartTime     float64 `json:"start_time"`
+	EndTime       float64 `json:"end_time"`
+	Duration      float64 `json:"duration"`
+	StartTimecode string  `json:"start_timecode"`
+	EndTimecode   string  `json:"end_timecode"`
+	FrameURL      *string `json:"frame_url,omitempty"`
+}
+
+// ShotsOutput carries the shot list. For the csv and edl formats Document
+// holds the rendered export and ContentType its media type.
+type ShotsOutput struct {
+	VideoID     uuid.UUID `json:"video_id"`
+	Filename    string    `json:"filename"`
+	FrameRate   int       `json:"frame_rate"`
+	Shots       []Shot    `json:"shots"`
+	Document    string    `json:"-"`
+	ContentType string    `json:"-"`
+}
+
+type ShotsUseCase interface {
+	Execute(ctx context.Context, cmd commands.ShotsCommand) (*ShotsOutput, error)
+}
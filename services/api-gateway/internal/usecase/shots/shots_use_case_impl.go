@@ -0,0 +1,192 @@
+package shots
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const (
+	presignedURLExpiry = 15 * time.Minute
+
+	// defaultFrameRate is used for timecodes when the source rate is unknown.
+	defaultFrameRate = 25
+
+	edlReelName = "AX"
+)
+
+type shotsUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	shotRepo        repositories.ShotRepository
+	s3Client        s3.S3Client
+	processedBucket string
+}
+
+func NewShotsUseCase(
+	videoRepo repositories.VideoRepository,
+	shotRepo repositories.ShotRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) ShotsUseCase {
+	return &shotsUseCaseImpl{
+		videoRepo:       videoRepo,
+		shotRepo:        shotRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+	}
+}
+
+func (uc *shotsUseCaseImpl) Execute(ctx context.Context, cmd commands.ShotsCommand) (*ShotsOutput, error) {
+	format := cmd.Format
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "csv" && format != "edl" {
+		return nil, errors.New("format must be json, csv or edl")
+	}
+
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	stored, err := uc.shotRepo.FindByVideoID(ctx, video.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shots: %w", err)
+	}
+
+	if len(stored) == 0 {
+		return nil, errors.New("shots not available")
+	}
+
+	rate := defaultFrameRate
+	if video.FrameRate != nil && *video.FrameRate > 0 {
+		rate = int(math.Round(*video.FrameRate))
+	}
+
+	output := &ShotsOutput{
+		VideoID:   video.ID,
+		Filename:  video.Filename,
+		FrameRate: rate,
+		Shots:     make([]Shot, len(stored)),
+	}
+
+	for i, s := range stored {
+		output.Shots[i] = Shot{
+			Index:         s.Index,
+			StartTime:     s.StartTime,
+			EndTime:       s.EndTime,
+			Duration:      math.Round((s.EndTime-s.StartTime)*1000) / 1000,
+			StartTimecode: formatTimecode(s.StartTime, rate),
+			EndTimecode:   formatTimecode(s.EndTime, rate),
+		}
+
+		// The EDL has no use for frame links, so skip signing them.
+		if s.FramePath != nil && format != "edl" {
+			url, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, *s.FramePath, presignedURLExpiry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate frame URL: %w", err)
+			}
+			output.Shots[i].FrameURL = &url
+		}
+	}
+
+	switch format {
+	case "csv":
+		document, err := buildCSV(output.Shots)
+		if err != nil {
+			return nil, err
+		}
+		output.Document = document
+		output.ContentType = "text/csv; charset=utf-8"
+	case "edl":
+		output.Document = buildEDL(output)
+		output.ContentType = "text/plain; charset=utf-8"
+	}
+
+	return output, nil
+}
+
+func buildCSV(shots []Shot) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	w.Write([]string{"shot", "start_time", "end_time", "duration", "start_timecode", "end_timecode", "frame_url"})
+	for _, s := range shots {
+		frameURL := ""
+		if s.FrameURL != nil {
+			frameURL = *s.FrameURL
+		}
+		w.Write([]string{
+			strconv.Itoa(s.Index),
+			strconv.FormatFloat(s.StartTime, 'f', 3, 64),
+			strconv.FormatFloat(s.EndTime, 'f', 3, 64),
+			strconv.FormatFloat(s.Duration, 'f', 3, 64),
+			s.StartTimecode,
+			s.EndTimecode,
+			frameURL,
+		})
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write shots csv: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// buildEDL renders the shots as a CMX3600 edit decision list of straight
+// cuts from a single reel. The record side lays the shots end to end, so it
+// matches the source timecodes for a video that covers every shot.
+func buildEDL(output *ShotsOutput) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "TITLE: %s\n", output.Filename)
+	b.WriteString("FCM: NON-DROP FRAME\n")
+
+	var record float64
+	for i, s := range output.Shots {
+		length := s.EndTime - s.StartTime
+		fmt.Fprintf(&b, "\n%03d  %-8s V     C        %s %s %s %s\n",
+			i+1,
+			edlReelName,
+			s.StartTimecode,
+			s.EndTimecode,
+			formatTimecode(record, output.FrameRate),
+			formatTimecode(record+length, output.FrameRate),
+		)
+		fmt.Fprintf(&b, "* FROM CLIP NAME: %s\n", output.Filename)
+		record += length
+	}
+
+	return b.String()
+}
+
+// formatTimecode converts seconds to a non-drop-frame HH:MM:SS:FF timecode,
+// snapping to the nearest frame so that cut points line up.
+func formatTimecode(seconds float64, rate int) string {
+	frames := int64(math.Round(seconds * float64(rate)))
+	fps := int64(rate)
+
+	ff := frames % fps
+	totalSeconds := frames / fps
+	return fmt.Sprintf("%02d:%02d:%02d:%02d", totalSeconds/3600, (totalSeconds/60)%60, totalSeconds%60, ff)
+}
@@ -0,0 +1,384 @@
+package shots
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockVideoRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoRepository) Create(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entities.Video, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Video), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
+func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+type MockShotRepository struct {
+	mock.Mock
+}
+
+func (m *MockShotRepository) FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.Shot, error) {
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Shot), args.Error(1)
+}
+
+func completedVideo(videoID uuid.UUID) *entities.Video {
+	frameRate := 24000.0 / 1001.0
+	return &entities.Video{
+		ID:        videoID,
+		UserID:    1,
+		Filename:  "test.mp4",
+		Status:    entities.StatusCompleted,
+		FrameRate: &frameRate,
+	}
+}
+
+func testShots(videoID uuid.UUID) []*entities.Shot {
+	framePath := "processed/" + videoID.String() + "/frames/frame_0002.jpg"
+	return []*entities.Shot{
+		{VideoID: videoID, Index: 1, StartTime: 0, EndTime: 4.5, FramePath: &framePath},
+		{VideoID: videoID, Index: 2, StartTime: 4.5, EndTime: 10},
+	}
+}
+
+func TestShotsUseCase_Execute_JSON(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	cmd := commands.ShotsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(completedVideo(videoID), nil)
+	mockShotRepo.On("FindByVideoID", ctx, videoID).Return(testShots(videoID), nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", "processed/"+videoID.String()+"/frames/frame_0002.jpg", 15*time.Minute).
+		Return("https://s3.example.com/frame_0002", nil)
+
+	useCase := NewShotsUseCase(mockRepo, mockShotRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 24, result.FrameRate)
+	assert.Len(t, result.Shots, 2)
+	assert.Equal(t, "00:00:04:12", result.Shots[0].EndTimecode)
+	assert.Equal(t, 5.5, result.Shots[1].Duration)
+	assert.Equal(t, "https://s3.example.com/frame_0002", *result.Shots[0].FrameURL)
+	assert.Nil(t, result.Shots[1].FrameURL)
+	assert.Empty(t, result.Document)
+
+	mockS3.AssertExpectations(t)
+}
+
+func TestShotsUseCase_Execute_CSV(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	cmd := commands.ShotsCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Format:  "csv",
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(completedVideo(videoID), nil)
+	mockShotRepo.On("FindByVideoID", ctx, videoID).Return(testShots(videoID), nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", mock.AnythingOfType("string"), 15*time.Minute).
+		Return("https://s3.example.com/frame_0002", nil)
+
+	useCase := NewShotsUseCase(mockRepo, mockShotRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "text/csv; charset=utf-8", result.ContentType)
+	assert.Equal(t,
+		"shot,start_time,end_time,duration,start_timecode,end_timecode,frame_url\n"+
+			"1,0.000,4.500,4.500,00:00:00:00,00:00:04:12,https://s3.example.com/frame_0002\n"+
+			"2,4.500,10.000,5.500,00:00:04:12,00:00:10:00,\n",
+		result.Document)
+}
+
+func TestShotsUseCase_Execute_EDL(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	cmd := commands.ShotsCommand{
+		VideoID: videoID,
+		UserID:  1,
+		Format:  "edl",
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(completedVideo(videoID), nil)
+	mockShotRepo.On("FindByVideoID", ctx, videoID).Return(testShots(videoID), nil)
+
+	useCase := NewShotsUseCase(mockRepo, mockShotRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"TITLE: test.mp4\n"+
+			"FCM: NON-DROP FRAME\n"+
+			"\n001  AX       V     C        00:00:00:00 00:00:04:12 00:00:00:00 00:00:04:12\n"+
+			"* FROM CLIP NAME: test.mp4\n"+
+			"\n002  AX       V     C        00:00:04:12 00:00:10:00 00:00:04:12 00:00:10:00\n"+
+			"* FROM CLIP NAME: test.mp4\n",
+		result.Document)
+
+	mockS3.AssertNotCalled(t, "GeneratePresignedURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestShotsUseCase_Execute_InvalidFormat(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockS3 := new(MockS3Client)
+
+	cmd := commands.ShotsCommand{
+		VideoID: uuid.New(),
+		UserID:  1,
+		Format:  "xml",
+	}
+
+	useCase := NewShotsUseCase(mockRepo, mockShotRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestShotsUseCase_Execute_NotAvailable(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	cmd := commands.ShotsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(completedVideo(videoID), nil)
+	mockShotRepo.On("FindByVideoID", ctx, videoID).Return([]*entities.Shot{}, nil)
+
+	useCase := NewShotsUseCase(mockRepo, mockShotRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "shots not available", err.Error())
+}
+
+func TestShotsUseCase_Execute_AccessDenied(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := completedVideo(videoID)
+	video.UserID = 2
+
+	cmd := commands.ShotsCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewShotsUseCase(mockRepo, mockShotRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "access denied", err.Error())
+	mockShotRepo.AssertNotCalled(t, "FindByVideoID", mock.Anything, mock.Anything)
+}
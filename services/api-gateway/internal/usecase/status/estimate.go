@@ -0,0 +1,135 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+)
+
+const (
+	// statsWindow is how many recently completed videos the estimate
+	// learns from.
+	statsWindow = 200
+
+	// minProfileSamples is how many completed videos with the same options
+	// profile the estimate needs before it prefers them over all videos.
+	minProfileSamples = 5
+)
+
+// progress is where a queued or running video stands.
+type progress struct {
+	QueuePosition *int64
+	CompletionAt  *time.Time
+}
+
+// estimate places a pending video in the queue and predicts when a pending
+// or processing video completes. The prediction is left out until some
+// video has completed.
+func (uc *statusUseCaseImpl) estimate(ctx context.Context, video *entities.Video, now time.Time) (*progress, error) {
+	result := &progress{}
+
+	global, err := uc.videoRepo.FindProcessingStats(ctx, "", statsWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load processing stats: %w", err)
+	}
+
+	var wait time.Duration
+	if video.Status == entities.StatusPending {
+		ahead, err := uc.videoRepo.CountQueuedAhead(ctx, video)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count queued videos: %w", err)
+		}
+		position := ahead + 1
+		result.QueuePosition = &position
+
+		wait, err = uc.queueWait(ctx, ahead, global)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stats, err := uc.profileStats(ctx, video.OptionsProfile, global)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, ok := predictDuration(video, stats)
+	if !ok {
+		return result, nil
+	}
+
+	start := now.Add(wait)
+	if video.Status == entities.StatusProcessing && video.StartedAt != nil {
+		start = *video.StartedAt
+	}
+	completion := start.Add(duration)
+	if completion.Before(now) {
+		// Running longer than predicted; it can only finish from now on.
+		completion = now
+	}
+	result.CompletionAt = &completion
+
+	return result, nil
+}
+
+// queueWait predicts how long the jobs ahead of a pending video and the
+// jobs already running keep the workers busy, taking a running job as half
+// done on average.
+func (uc *statusUseCaseImpl) queueWait(ctx context.Context, ahead int64, stats *entities.ProcessingStats) (time.Duration, error) {
+	if stats.Count == 0 {
+		return 0, nil
+	}
+
+	running, err := uc.videoRepo.CountByStatus(ctx, entities.StatusProcessing)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count processing videos: %w", err)
+	}
+
+	// Each worker runs one processing job at a time. Without a live worker
+	// the queue still drains once one starts, so count one.
+	workers := 1
+	if alive, err := uc.workerRepo.FindAlive(ctx); err == nil && len(alive) > 0 {
+		workers = len(alive)
+	}
+
+	mean := stats.Seconds / float64(stats.Count)
+	busy := (float64(ahead) + float64(running)/2) * mean / float64(workers)
+	return time.Duration(busy * float64(time.Second)), nil
+}
+
+// profileStats returns the stats of videos processed with the same options
+// profile, or the global stats when too few of those completed.
+func (uc *statusUseCaseImpl) profileStats(ctx context.Context, profile *string, global *entities.ProcessingStats) (*entities.ProcessingStats, error) {
+	if profile == nil || *profile == "" || global.Count < minProfileSamples {
+		return global, nil
+	}
+
+	stats, err := uc.videoRepo.FindProcessingStats(ctx, *profile, statsWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load processing stats: %w", err)
+	}
+	if stats.Count < minProfileSamples {
+		return global, nil
+	}
+	return stats, nil
+}
+
+// predictDuration predicts how long a video takes to process from the
+// processing time per second of source when its duration is known, per
+// byte of upload when its size is, and the mean processing time otherwise.
+func predictDuration(video *entities.Video, stats *entities.ProcessingStats) (time.Duration, bool) {
+	var seconds float64
+	switch {
+	case video.SourceDuration != nil && *video.SourceDuration > 0 && stats.SourceSeconds > 0:
+		seconds = *video.SourceDuration * stats.TimedSeconds / stats.SourceSeconds
+	case video.FileSize != nil && *video.FileSize > 0 && stats.Bytes > 0:
+		seconds = float64(*video.FileSize) * stats.SizedSeconds / float64(stats.Bytes)
+	case stats.Count > 0:
+		seconds = stats.Seconds / float64(stats.Count)
+	default:
+		return 0, false
+	}
+	return time.Duration(seconds * float64(time.Second)), true
+}
@@ -8,15 +8,37 @@ import (
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
 )
 
+type SubtitleTrack struct {
+	Language string `json:"language"`
+	Codec    string `json:"codec"`
+	SRTURL   string `json:"srt_url"`
+	VTTURL   string `json:"vtt_url"`
+}
+
 type StatusOutput struct {
-	VideoID      uuid.UUID  `json:"video_id"`
-	Filename     string     `json:"filename"`
-	Status       string     `json:"status"`
-	FrameCount   *int       `json:"frame_count"`
-	ErrorMessage *string    `json:"error_message"`
-	CreatedAt    time.Time  `json:"created_at"`
-	StartedAt    *time.Time `json:"started_at"`
-	CompletedAt  *time.Time `json:"completed_at"`
+	VideoID              uuid.UUID  `json:"video_id"`
+	Filename             string     `json:"filename"`
+	Status               string     `json:"status"`
+	FrameCount           *int       `json:"frame_count"`
+	DuplicateFrameCount  *int       `json:"duplicate_frame_count,omitempty"`
+	LowQualityFrameCount *int       `json:"low_quality_frame_count,omitempty"`
+	InactiveFrameCount   *int       `json:"inactive_frame_count,omitempty"`
+	ErrorMessage         *string    `json:"error_message"`
+	ErrorCode            *string    `json:"error_code,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	StartedAt            *time.Time `json:"started_at"`
+	CompletedAt          *time.Time `json:"completed_at"`
+	HasAudio             *bool      `json:"has_audio"`
+	AudioURL             *string    `json:"audio_url,omitempty"`
+
+	// Set until a direct upload is confirmed
+	AwaitingUpload bool `json:"awaiting_upload,omitempty"`
+
+	// Set while the video is pending or processing
+	QueuePosition         *int64     `json:"queue_position,omitempty"`
+	EstimatedCompletionAt *time.Time `json:"estimated_completion_at,omitempty"`
+
+	SubtitleTracks []SubtitleTrack `json:"subtitle_tracks,omitempty"`
 }
 
 type StatusUseCase interface {
@@ -3,18 +3,38 @@ package status
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
+const presignedURLExpiry = 15 * time.Minute
+
 type statusUseCaseImpl struct {
-	videoRepo repositories.VideoRepository
+	videoRepo       repositories.VideoRepository
+	subtitleRepo    repositories.SubtitleTrackRepository
+	workerRepo      repositories.WorkerRepository
+	s3Client        s3.S3Client
+	processedBucket string
 }
 
-func NewStatusUseCase(videoRepo repositories.VideoRepository) StatusUseCase {
+func NewStatusUseCase(
+	videoRepo repositories.VideoRepository,
+	subtitleRepo repositories.SubtitleTrackRepository,
+	workerRepo repositories.WorkerRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) StatusUseCase {
 	return &statusUseCaseImpl{
-		videoRepo: videoRepo,
+		videoRepo:       videoRepo,
+		subtitleRepo:    subtitleRepo,
+		workerRepo:      workerRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
 	}
 }
 
@@ -28,14 +48,68 @@ func (uc *statusUseCaseImpl) Execute(ctx context.Context, cmd commands.StatusCom
 		return nil, errors.New("access denied")
 	}
 
-	return &StatusOutput{
-		VideoID:      video.ID,
-		Filename:     video.Filename,
-		Status:       string(video.Status),
-		FrameCount:   video.FrameCount,
-		ErrorMessage: video.ErrorMessage,
-		CreatedAt:    video.CreatedAt,
-		StartedAt:    video.StartedAt,
-		CompletedAt:  video.CompletedAt,
-	}, nil
+	output := &StatusOutput{
+		VideoID:              video.ID,
+		Filename:             video.Filename,
+		Status:               string(video.Status),
+		FrameCount:           video.FrameCount,
+		DuplicateFrameCount:  video.DuplicateFrameCount,
+		LowQualityFrameCount: video.LowQualityFrameCount,
+		InactiveFrameCount:   video.InactiveFrameCount,
+		ErrorMessage:         video.ErrorMessage,
+		ErrorCode:            video.ErrorCode,
+		CreatedAt:            video.CreatedAt,
+		StartedAt:            video.StartedAt,
+		CompletedAt:          video.CompletedAt,
+		HasAudio:             video.HasAudio,
+		AwaitingUpload:       video.AwaitingUpload,
+	}
+
+	// A video awaiting its upload is not queued, so there is nothing to
+	// estimate yet.
+	if !video.AwaitingUpload && (video.Status == entities.StatusPending || video.Status == entities.StatusProcessing) {
+		progress, err := uc.estimate(ctx, video, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		output.QueuePosition = progress.QueuePosition
+		output.EstimatedCompletionAt = progress.CompletionAt
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return output, nil
+	}
+
+	if video.AudioPath != nil {
+		url, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, *video.AudioPath, presignedURLExpiry)
+		if err != nil {
+			return nil, err
+		}
+		output.AudioURL = &url
+	}
+
+	tracks, err := uc.subtitleRepo.FindByVideoID(ctx, video.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load subtitle tracks: %w", err)
+	}
+
+	for _, track := range tracks {
+		srtURL, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, track.SRTPath, presignedURLExpiry)
+		if err != nil {
+			return nil, err
+		}
+		vttURL, err := uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, track.VTTPath, presignedURLExpiry)
+		if err != nil {
+			return nil, err
+		}
+
+		output.SubtitleTracks = append(output.SubtitleTracks, SubtitleTrack{
+			Language: track.Language,
+			Codec:    track.Codec,
+			SRTURL:   srtURL,
+			VTTURL:   vttURL,
+		})
+	}
+
+	return output, nil
 }
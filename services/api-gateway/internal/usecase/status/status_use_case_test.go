@@ -3,6 +3,7 @@ package status
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
 type MockVideoRepository struct {
@@ -43,15 +45,157 @@ func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
 func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
 	args := m.Called(ctx, id, status)
 	return args.Error(0)
 }
 
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+type MockSubtitleTrackRepository struct {
+	mock.Mock
+}
+
+func (m *MockSubtitleTrackRepository) FindByVideoID(ctx context.Context, videoID uuid.UUID) ([]*entities.SubtitleTrack, error) {
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.SubtitleTrack), args.Error(1)
+}
+
+type MockWorkerRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorkerRepository) FindAlive(ctx context.Context) ([]*entities.Worker, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Worker), args.Error(1)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
 func TestStatusUseCase_Execute_Success(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
 
 	videoID := uuid.New()
 	createdAt := time.Now()
@@ -71,8 +215,9 @@ func TestStatusUseCase_Execute_Success(t *testing.T) {
 	}
 
 	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockSubtitleRepo.On("FindByVideoID", ctx, videoID).Return([]*entities.SubtitleTrack{}, nil)
 
-	useCase := NewStatusUseCase(mockRepo)
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -93,6 +238,8 @@ func TestStatusUseCase_Execute_VideoNotFound(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
 
 	videoID := uuid.New()
 
@@ -103,7 +250,7 @@ func TestStatusUseCase_Execute_VideoNotFound(t *testing.T) {
 
 	mockRepo.On("FindByID", ctx, videoID).Return(nil, errors.New("not found"))
 
-	useCase := NewStatusUseCase(mockRepo)
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -120,6 +267,8 @@ func TestStatusUseCase_Execute_AccessDenied(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
 
 	videoID := uuid.New()
 	video := &entities.Video{
@@ -136,7 +285,7 @@ func TestStatusUseCase_Execute_AccessDenied(t *testing.T) {
 
 	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
 
-	useCase := NewStatusUseCase(mockRepo)
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -153,6 +302,8 @@ func TestStatusUseCase_Execute_ProcessingStatus(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
 
 	videoID := uuid.New()
 	startedAt := time.Now()
@@ -171,8 +322,9 @@ func TestStatusUseCase_Execute_ProcessingStatus(t *testing.T) {
 	}
 
 	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockRepo.On("FindProcessingStats", ctx, "", statsWindow).Return(&entities.ProcessingStats{}, nil)
 
-	useCase := NewStatusUseCase(mockRepo)
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -182,6 +334,8 @@ func TestStatusUseCase_Execute_ProcessingStatus(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, "PROCESSING", result.Status)
 	assert.NotNil(t, result.StartedAt)
+	assert.Nil(t, result.QueuePosition)
+	assert.Nil(t, result.EstimatedCompletionAt)
 
 	mockRepo.AssertExpectations(t)
 }
@@ -190,15 +344,19 @@ func TestStatusUseCase_Execute_FailedStatus(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
 
 	videoID := uuid.New()
 	errorMsg := "FFmpeg processing failed"
+	errorCode := "NO_VIDEO_STREAM"
 	video := &entities.Video{
 		ID:           videoID,
 		UserID:       1,
 		Filename:     "test.mp4",
 		Status:       entities.StatusFailed,
 		ErrorMessage: &errorMsg,
+		ErrorCode:    &errorCode,
 		CreatedAt:    time.Now(),
 	}
 
@@ -209,7 +367,7 @@ func TestStatusUseCase_Execute_FailedStatus(t *testing.T) {
 
 	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
 
-	useCase := NewStatusUseCase(mockRepo)
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -220,6 +378,275 @@ func TestStatusUseCase_Execute_FailedStatus(t *testing.T) {
 	assert.Equal(t, "FAILED", result.Status)
 	assert.NotNil(t, result.ErrorMessage)
 	assert.Equal(t, "FFmpeg processing failed", *result.ErrorMessage)
+	assert.Equal(t, &errorCode, result.ErrorCode)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestStatusUseCase_Execute_IncludesAudioURL(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	hasAudio := true
+	audioPath := "processed/" + videoID.String() + "/audio/audio.mp3"
+	video := &entities.Video{
+		ID:        videoID,
+		UserID:    1,
+		Filename:  "interview.mp4",
+		Status:    entities.StatusCompleted,
+		HasAudio:  &hasAudio,
+		AudioPath: &audioPath,
+		CreatedAt: time.Now(),
+	}
+
+	cmd := commands.StatusCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockSubtitleRepo.On("FindByVideoID", ctx, videoID).Return([]*entities.SubtitleTrack{}, nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", audioPath, 15*time.Minute).Return("https://s3.example.com/audio.mp3", nil)
+
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result.HasAudio)
+	assert.True(t, *result.HasAudio)
+	assert.NotNil(t, result.AudioURL)
+	assert.Equal(t, "https://s3.example.com/audio.mp3", *result.AudioURL)
+
+	mockRepo.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+}
+
+func TestStatusUseCase_Execute_ListsSubtitleTracks(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:        videoID,
+		UserID:    1,
+		Filename:  "broadcast.mkv",
+		Status:    entities.StatusCompleted,
+		CreatedAt: time.Now(),
+	}
+
+	tracks := []*entities.SubtitleTrack{
+		{VideoID: videoID, StreamIndex: 2, Language: "eng", Codec: "subrip", SRTPath: "subs/eng.srt", VTTPath: "subs/eng.vtt"},
+	}
+
+	cmd := commands.StatusCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockSubtitleRepo.On("FindByVideoID", ctx, videoID).Return(tracks, nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", "subs/eng.srt", 15*time.Minute).Return("https://s3.example.com/eng.srt", nil)
+	mockS3.On("GeneratePresignedURL", ctx, "processed-bucket", "subs/eng.vtt", 15*time.Minute).Return("https://s3.example.com/eng.vtt", nil)
+
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.SubtitleTracks, 1)
+	assert.Equal(t, "eng", result.SubtitleTracks[0].Language)
+	assert.Equal(t, "https://s3.example.com/eng.srt", result.SubtitleTracks[0].SRTURL)
+	assert.Equal(t, "https://s3.example.com/eng.vtt", result.SubtitleTracks[0].VTTURL)
+
+	mockRepo.AssertExpectations(t)
+	mockSubtitleRepo.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+}
+
+func TestStatusUseCase_Execute_EstimatesQueuedVideo(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockWorkerRepo := new(MockWorkerRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	fileSize := int64(50 << 20)
+	video := &entities.Video{
+		ID:        videoID,
+		UserID:    1,
+		Filename:  "test.mp4",
+		Status:    entities.StatusPending,
+		FileSize:  &fileSize,
+		CreatedAt: time.Now(),
+	}
+
+	cmd := commands.StatusCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	// 10 videos took 600s in total, 500MB of uploads taking 400s of it
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockRepo.On("FindProcessingStats", ctx, "", statsWindow).Return(&entities.ProcessingStats{
+		Count:        10,
+		Seconds:      600,
+		SizedSeconds: 400,
+		Bytes:        500 << 20,
+	}, nil)
+	mockRepo.On("CountQueuedAhead", ctx, video).Return(int64(3), nil)
+	mockRepo.On("CountByStatus", ctx, entities.StatusProcessing).Return(int64(2), nil)
+	mockWorkerRepo.On("FindAlive", ctx).Return([]*entities.Worker{{ID: "a"}, {ID: "b"}}, nil)
+
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, mockWorkerRepo, mockS3, "processed-bucket")
+
+	// Act
+	before := time.Now()
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), *result.QueuePosition)
+
+	// (3 ahead + 2 running / 2) * 60s mean / 2 workers, then 40s for 50MB
+	expected := before.Add(120*time.Second + 40*time.Second)
+	assert.WithinDuration(t, expected, *result.EstimatedCompletionAt, time.Second)
 
 	mockRepo.AssertExpectations(t)
+	mockWorkerRepo.AssertExpectations(t)
+}
+
+func TestStatusUseCase_Execute_AwaitingUploadHasNoEstimate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockWorkerRepo := new(MockWorkerRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	video := &entities.Video{
+		ID:             videoID,
+		UserID:         1,
+		Filename:       "test.mp4",
+		Status:         entities.StatusPending,
+		AwaitingUpload: true,
+		CreatedAt:      time.Now(),
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, mockWorkerRepo, mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.StatusCommand{VideoID: videoID, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.AwaitingUpload)
+	assert.Nil(t, result.QueuePosition)
+	assert.Nil(t, result.EstimatedCompletionAt)
+	mockRepo.AssertNotCalled(t, "CountQueuedAhead", mock.Anything, mock.Anything)
+}
+
+func TestStatusUseCase_Execute_EstimatesWithProfileStats(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockS3 := new(MockS3Client)
+
+	videoID := uuid.New()
+	startedAt := time.Now().Add(-time.Minute)
+	duration := 120.0
+	profile := "shots,hls:3"
+	video := &entities.Video{
+		ID:             videoID,
+		UserID:         1,
+		Filename:       "test.mp4",
+		Status:         entities.StatusProcessing,
+		SourceDuration: &duration,
+		OptionsProfile: &profile,
+		StartedAt:      &startedAt,
+	}
+
+	cmd := commands.StatusCommand{
+		VideoID: videoID,
+		UserID:  1,
+	}
+
+	mockRepo.On("FindByID", ctx, videoID).Return(video, nil)
+	mockRepo.On("FindProcessingStats", ctx, "", statsWindow).Return(&entities.ProcessingStats{
+		Count:         20,
+		Seconds:       1000,
+		TimedSeconds:  1000,
+		SourceSeconds: 4000,
+	}, nil)
+	mockRepo.On("FindProcessingStats", ctx, profile, statsWindow).Return(&entities.ProcessingStats{
+		Count:         5,
+		Seconds:       600,
+		TimedSeconds:  600,
+		SourceSeconds: 600,
+	}, nil)
+
+	useCase := NewStatusUseCase(mockRepo, mockSubtitleRepo, new(MockWorkerRepository), mockS3, "processed-bucket")
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, result.QueuePosition)
+
+	// One second of processing per second of source with this profile
+	assert.WithinDuration(t, startedAt.Add(2*time.Minute), *result.EstimatedCompletionAt, time.Second)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPredictDuration(t *testing.T) {
+	duration := 60.0
+	size := int64(1000)
+	stats := &entities.ProcessingStats{
+		Count:         4,
+		Seconds:       400,
+		SizedSeconds:  200,
+		Bytes:         4000,
+		TimedSeconds:  300,
+		SourceSeconds: 600,
+	}
+
+	tests := []struct {
+		name     string
+		video    *entities.Video
+		stats    *entities.ProcessingStats
+		expected time.Duration
+		ok       bool
+	}{
+		{"per second of source", &entities.Video{SourceDuration: &duration, FileSize: &size}, stats, 30 * time.Second, true},
+		{"per byte", &entities.Video{FileSize: &size}, stats, 50 * time.Second, true},
+		{"mean", &entities.Video{}, stats, 100 * time.Second, true},
+		{"no history", &entities.Video{FileSize: &size}, &entities.ProcessingStats{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := predictDuration(tt.video, tt.stats)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
 }
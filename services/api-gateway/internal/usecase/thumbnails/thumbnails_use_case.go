@@ -0,0 +1,16 @@
+package thumbnails
+
+import (
+	"context"
+
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type ThumbnailsOutput struct {
+	VTT       string
+	ExpiresIn int64
+}
+
+type ThumbnailsUseCase interface {
+	Execute(ctx context.Context, cmd commands.ThumbnailsCommand) (*ThumbnailsOutput, error)
+}
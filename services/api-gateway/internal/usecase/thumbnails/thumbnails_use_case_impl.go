@@ -0,0 +1,103 @@
+package thumbnails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+// Players keep the track around for the whole viewing session, so the sprite
+// links need to outlive the usual download expiry.
+const presignedURLExpiry = time.Hour
+
+type thumbnailsUseCaseImpl struct {
+	videoRepo       repositories.VideoRepository
+	s3Client        s3.S3Client
+	processedBucket string
+}
+
+func NewThumbnailsUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	processedBucket string,
+) ThumbnailsUseCase {
+	return &thumbnailsUseCaseImpl{
+		videoRepo:       videoRepo,
+		s3Client:        s3Client,
+		processedBucket: processedBucket,
+	}
+}
+
+func (uc *thumbnailsUseCaseImpl) Execute(ctx context.Context, cmd commands.ThumbnailsCommand) (*ThumbnailsOutput, error) {
+	video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if video.Status != entities.StatusCompleted {
+		return nil, errors.New("video processing not completed")
+	}
+
+	spritePrefix := fmt.Sprintf("processed/%s/sprites/", video.ID)
+
+	reader, err := uc.s3Client.GetObject(ctx, uc.processedBucket, spritePrefix+"thumbnails.vtt")
+	if err != nil {
+		return nil, errors.New("thumbnails not available")
+	}
+	defer reader.Close()
+
+	track, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read thumbnails track: %w", err)
+	}
+
+	vtt, err := uc.signSpriteReferences(ctx, string(track), spritePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ThumbnailsOutput{
+		VTT:       vtt,
+		ExpiresIn: int64(presignedURLExpiry.Seconds()),
+	}, nil
+}
+
+// signSpriteReferences swaps the relative sprite names in each cue for
+// presigned URLs, keeping the #xywh fragment that selects the tile.
+func (uc *thumbnailsUseCaseImpl) signSpriteReferences(ctx context.Context, track, spritePrefix string) (string, error) {
+	signed := make(map[string]string)
+	lines := strings.Split(track, "\n")
+
+	for i, line := range lines {
+		name, fragment, ok := strings.Cut(line, "#xywh=")
+		if !ok {
+			continue
+		}
+
+		url, found := signed[name]
+		if !found {
+			var err error
+			url, err = uc.s3Client.GeneratePresignedURL(ctx, uc.processedBucket, spritePrefix+name, presignedURLExpiry)
+			if err != nil {
+				return "", err
+			}
+			signed[name] = url
+		}
+
+		lines[i] = url + "#xywh=" + fragment
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
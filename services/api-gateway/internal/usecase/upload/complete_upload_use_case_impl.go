@@ -0,0 +1,156 @@
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type completeUploadUseCaseImpl struct {
+	s3Client s3.S3Client
+	queue    processingQueue
+}
+
+func NewCompleteUploadUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	publisher rabbitmq.Publisher,
+	dispatch DispatchPolicy,
+) CompleteUploadUseCase {
+	return &completeUploadUseCaseImpl{
+		s3Client: s3Client,
+		queue: processingQueue{
+			videoRepo: videoRepo,
+			publisher: publisher,
+			dispatch:  dispatch,
+		},
+	}
+}
+
+// Execute checks that the file reached S3 with the announced size and the
+// container its extension claims, then queues the video.
+func (uc *completeUploadUseCaseImpl) Execute(ctx context.Context, cmd commands.CompleteUploadCommand) (*UploadOutput, error) {
+	video, err := uc.queue.videoRepo.FindByID(ctx, cmd.VideoID)
+	if err != nil {
+		return nil, errors.New("video not found")
+	}
+
+	if video.UserID != cmd.UserID {
+		return nil, errors.New("access denied")
+	}
+
+	if !video.AwaitingUpload {
+		return nil, ErrUploadConfirmed
+	}
+	if time.Now().After(video.ExpiresAt) {
+		return nil, ErrUploadExpired
+	}
+
+	if err := uc.verifyObject(ctx, video); err != nil {
+		return nil, err
+	}
+
+	var options commands.ProcessingOptions
+	if video.ProcessingOptions != nil {
+		if err := json.Unmarshal([]byte(*video.ProcessingOptions), &options); err != nil {
+			return nil, fmt.Errorf("failed to decode processing options: %w", err)
+		}
+	}
+
+	if err := uc.queue.prioritize(ctx, video, options); err != nil {
+		return nil, err
+	}
+
+	// Marking the video first lets only one of two concurrent confirms
+	// queue it.
+	uploadExpiry := video.ExpiresAt
+	video.ExpiresAt = time.Now().Add(retention)
+	if err := uc.queue.videoRepo.MarkUploaded(ctx, video); err != nil {
+		if errors.Is(err, repositories.ErrUploadConfirmed) {
+			return nil, ErrUploadConfirmed
+		}
+		return nil, fmt.Errorf("failed to update video record: %w", err)
+	}
+
+	if err := uc.queue.publish(ctx, video, options); err != nil {
+		// Reopen the upload so the client can confirm it again.
+		video.ExpiresAt = uploadExpiry
+		if reopenErr := uc.queue.videoRepo.ReopenUpload(ctx, video); reopenErr != nil {
+			logging.Error("Failed to reopen upload", "video_id", video.ID, "error", reopenErr)
+		}
+		return nil, err
+	}
+
+	return &UploadOutput{
+		VideoID:  video.ID,
+		Filename: video.Filename,
+		Status:   string(entities.StatusPending),
+	}, nil
+}
+
+// verifyObject assembles a multipart upload unless an earlier attempt
+// already did, and checks the stored object.
+func (uc *completeUploadUseCaseImpl) verifyObject(ctx context.Context, video *entities.Video) error {
+	object, err := findObject(ctx, uc.s3Client, video.OriginalPath)
+	if err != nil {
+		return err
+	}
+
+	if object == nil && video.MultipartUploadID != nil {
+		if err := uc.s3Client.CompleteMultipartUpload(ctx, "", video.OriginalPath, *video.MultipartUploadID); err != nil {
+			return fmt.Errorf("%w: %v", ErrObjectMissing, err)
+		}
+		if object, err = findObject(ctx, uc.s3Client, video.OriginalPath); err != nil {
+			return err
+		}
+	}
+
+	if object == nil {
+		return ErrObjectMissing
+	}
+	if object.Size != *video.FileSize {
+		return fmt.Errorf("%w: expected %d bytes, got %d", ErrSizeMismatch, *video.FileSize, object.Size)
+	}
+
+	reader, err := uc.s3Client.GetObject(ctx, "", video.OriginalPath)
+	if err != nil {
+		return fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+	defer reader.Close()
+
+	header := make([]byte, sniffLen)
+	n, err := io.ReadFull(reader, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+
+	return checkContainer(header[:n], strings.ToLower(filepath.Ext(video.Filename)))
+}
+
+// findObject returns the object stored under the key, or nil when there is
+// none.
+func findObject(ctx context.Context, s3Client s3.S3Client, key string) (*s3.ObjectInfo, error) {
+	objects, err := s3Client.ListObjectDetails(ctx, "", key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up uploaded file: %w", err)
+	}
+
+	for _, object := range objects {
+		if object.Key == key {
+			return &object, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,82 @@
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type createTusUploadUseCaseImpl struct {
+	tusRepo  repositories.TusUploadRepository
+	s3Client s3.S3Client
+}
+
+func NewCreateTusUploadUseCase(
+	tusRepo repositories.TusUploadRepository,
+	s3Client s3.S3Client,
+) CreateTusUploadUseCase {
+	return &createTusUploadUseCaseImpl{
+		tusRepo:  tusRepo,
+		s3Client: s3Client,
+	}
+}
+
+// Execute validates the upload up front, so a client learns about a bad
+// file name or options before sending any data.
+func (uc *createTusUploadUseCaseImpl) Execute(ctx context.Context, cmd commands.CreateTusUploadCommand) (*TusUploadOutput, error) {
+	if cmd.Length <= 0 {
+		return nil, errors.New("upload length must be positive")
+	}
+
+	filename := filepath.Base(cmd.Filename)
+	if err := validateUpload(filename, cmd.Length, cmd.Options); err != nil {
+		return nil, err
+	}
+
+	if err := checkWatermarkAsset(ctx, uc.s3Client, cmd.UserID, cmd.Options); err != nil {
+		return nil, err
+	}
+
+	options, err := json.Marshal(cmd.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode processing options: %w", err)
+	}
+
+	id := uuid.New()
+	s3Key := uploadKey(id, filename)
+
+	multipartID, err := uc.s3Client.CreateMultipartUpload(ctx, "", s3Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start upload: %w", err)
+	}
+
+	upload := &entities.TusUpload{
+		ID:          id,
+		UserID:      cmd.UserID,
+		Filename:    filename,
+		Length:      cmd.Length,
+		S3Key:       s3Key,
+		MultipartID: multipartID,
+		Options:     string(options),
+		ExpiresAt:   time.Now().Add(tusExpiry),
+	}
+
+	if err := uc.tusRepo.Create(ctx, upload); err != nil {
+		if abortErr := uc.s3Client.AbortMultipartUpload(ctx, "", s3Key, multipartID); abortErr != nil {
+			logging.Warn("Failed to abort multipart upload", "s3_key", s3Key, "error", abortErr)
+		}
+		return nil, fmt.Errorf("failed to create upload record: %w", err)
+	}
+
+	return tusOutput(upload), nil
+}
@@ -0,0 +1,127 @@
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type createUploadURLUseCaseImpl struct {
+	videoRepo repositories.VideoRepository
+	s3Client  s3.S3Client
+}
+
+func NewCreateUploadURLUseCase(
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+) CreateUploadURLUseCase {
+	return &createUploadURLUseCaseImpl{
+		videoRepo: videoRepo,
+		s3Client:  s3Client,
+	}
+}
+
+// Execute creates the video as awaiting its upload and presigns the upload
+// of its source, in parts for large files.
+func (uc *createUploadURLUseCaseImpl) Execute(ctx context.Context, cmd commands.CreateUploadURLCommand) (*UploadURLOutput, error) {
+	if cmd.FileSize <= 0 {
+		return nil, errors.New("file size must be positive")
+	}
+
+	filename := filepath.Base(cmd.Filename)
+	if err := validateUpload(filename, cmd.FileSize, cmd.Options); err != nil {
+		return nil, err
+	}
+
+	if err := checkWatermarkAsset(ctx, uc.s3Client, cmd.UserID, cmd.Options); err != nil {
+		return nil, err
+	}
+
+	options, err := json.Marshal(cmd.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode processing options: %w", err)
+	}
+
+	videoID := uuid.New()
+	s3Key := uploadKey(videoID, filename)
+
+	video := newPendingVideo(storedUpload{
+		VideoID:  videoID,
+		UserID:   cmd.UserID,
+		Filename: filename,
+		S3Key:    s3Key,
+		FileSize: cmd.FileSize,
+		Options:  cmd.Options,
+	})
+	optionsJSON := string(options)
+	video.AwaitingUpload = true
+	video.ProcessingOptions = &optionsJSON
+	video.ExpiresAt = time.Now().Add(directUploadWindow)
+
+	output := &UploadURLOutput{
+		VideoID:   videoID,
+		Filename:  filename,
+		ExpiresIn: int64(directUploadURLExpiry.Seconds()),
+	}
+
+	if cmd.FileSize <= directMultipartThreshold {
+		output.URL, err = uc.s3Client.GeneratePresignedPutURL(ctx, "", s3Key, cmd.FileSize, directUploadURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate upload URL: %w", err)
+		}
+	} else {
+		multipartID, err := uc.s3Client.CreateMultipartUpload(ctx, "", s3Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start upload: %w", err)
+		}
+		video.MultipartUploadID = &multipartID
+
+		output.PartSize = directPartSize
+		output.Parts, err = uc.presignParts(ctx, s3Key, multipartID, cmd.FileSize)
+		if err != nil {
+			uc.abort(ctx, s3Key, multipartID)
+			return nil, err
+		}
+	}
+
+	if err := uc.videoRepo.Create(ctx, video); err != nil {
+		if video.MultipartUploadID != nil {
+			uc.abort(ctx, s3Key, *video.MultipartUploadID)
+		}
+		return nil, fmt.Errorf("failed to create video record: %w", err)
+	}
+
+	return output, nil
+}
+
+func (uc *createUploadURLUseCaseImpl) presignParts(ctx context.Context, s3Key, multipartID string, size int64) ([]UploadPartURL, error) {
+	var parts []UploadPartURL
+	for offset, number := int64(0), int32(1); offset < size; offset, number = offset+directPartSize, number+1 {
+		url, err := uc.s3Client.GeneratePresignedPartURL(ctx, "", s3Key, multipartID, number, directUploadURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate upload URL: %w", err)
+		}
+
+		parts = append(parts, UploadPartURL{
+			PartNumber: number,
+			Size:       min(directPartSize, size-offset),
+			URL:        url,
+		})
+	}
+	return parts, nil
+}
+
+func (uc *createUploadURLUseCaseImpl) abort(ctx context.Context, s3Key, multipartID string) {
+	if err := uc.s3Client.AbortMultipartUpload(ctx, "", s3Key, multipartID); err != nil {
+		logging.Warn("Failed to abort multipart upload", "s3_key", s3Key, "error", err)
+	}
+}
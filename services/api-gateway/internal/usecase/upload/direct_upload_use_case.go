@@ -0,0 +1,56 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+const (
+	// directUploadURLExpiry is how long the presigned URLs are valid.
+	directUploadURLExpiry = time.Hour
+
+	// directUploadWindow is how long a video awaits its upload before
+	// cleanup removes it.
+	directUploadWindow = 24 * time.Hour
+
+	// Files above directMultipartThreshold are uploaded in parts of
+	// directPartSize, so a failed part can be retried on its own.
+	directMultipartThreshold = 64 << 20
+	directPartSize           = 16 << 20
+)
+
+var (
+	ErrUploadConfirmed = errors.New("video upload was already confirmed")
+	ErrObjectMissing   = errors.New("uploaded file not found")
+	ErrSizeMismatch    = errors.New("uploaded file size does not match")
+)
+
+// UploadPartURL is where to PUT one part of a multipart upload.
+type UploadPartURL struct {
+	PartNumber int32
+	Size       int64
+	URL        string
+}
+
+// UploadURLOutput tells the client where to upload the file: a single
+// presigned PUT to URL, or one PUT per part of Parts.
+type UploadURLOutput struct {
+	VideoID   uuid.UUID
+	Filename  string
+	URL       string
+	Parts     []UploadPartURL
+	PartSize  int64
+	ExpiresIn int64
+}
+
+type CreateUploadURLUseCase interface {
+	Execute(ctx context.Context, cmd commands.CreateUploadURLCommand) (*UploadURLOutput, error)
+}
+
+type CompleteUploadUseCase interface {
+	Execute(ctx context.Context, cmd commands.CompleteUploadCommand) (*UploadOutput, error)
+}
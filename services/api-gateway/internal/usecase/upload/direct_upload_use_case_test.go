@@ -0,0 +1,336 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+func newAwaitingVideo(size int64) *entities.Video {
+	id := uuid.New()
+	options := `{"priority":"low"}`
+	return &entities.Video{
+		ID:                id,
+		UserID:            1,
+		Filename:          "test.mp4",
+		OriginalPath:      uploadKey(id, "test.mp4"),
+		Status:            entities.StatusPending,
+		FileSize:          &size,
+		AwaitingUpload:    true,
+		ProcessingOptions: &options,
+		ExpiresAt:         time.Now().Add(time.Hour),
+	}
+}
+
+func TestCreateUploadURLUseCase_Execute_SinglePut(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	var created *entities.Video
+	mockS3.On("GeneratePresignedPutURL", ctx, "", mock.AnythingOfType("string"), int64(1024), directUploadURLExpiry).
+		Return("https://s3.example.com/put", nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Video")).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*entities.Video)
+	}).Return(nil)
+
+	useCase := NewCreateUploadURLUseCase(mockRepo, mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CreateUploadURLCommand{
+		UserID:   1,
+		Filename: "test.mp4",
+		FileSize: 1024,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "https://s3.example.com/put", result.URL)
+	assert.Empty(t, result.Parts)
+	assert.Equal(t, result.VideoID, created.ID)
+	assert.Equal(t, "uploads/"+result.VideoID.String()+"/test.mp4", created.OriginalPath)
+	assert.Equal(t, entities.StatusPending, created.Status)
+	assert.True(t, created.AwaitingUpload)
+	assert.Nil(t, created.MultipartUploadID)
+	assert.WithinDuration(t, time.Now().Add(directUploadWindow), created.ExpiresAt, time.Minute)
+	mockS3.AssertExpectations(t)
+}
+
+func TestCreateUploadURLUseCase_Execute_Multipart(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	size := int64(4*directPartSize + 100)
+	mockS3.On("CreateMultipartUpload", ctx, "", mock.AnythingOfType("string")).Return("multipart-1", nil)
+	mockS3.On("GeneratePresignedPartURL", ctx, "", mock.AnythingOfType("string"), "multipart-1", mock.AnythingOfType("int32"), directUploadURLExpiry).
+		Return("https://s3.example.com/part", nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(video *entities.Video) bool {
+		return video.MultipartUploadID != nil && *video.MultipartUploadID == "multipart-1"
+	})).Return(nil)
+
+	useCase := NewCreateUploadURLUseCase(mockRepo, mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CreateUploadURLCommand{
+		UserID:   1,
+		Filename: "test.mp4",
+		FileSize: size,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, result.URL)
+	assert.Equal(t, int64(directPartSize), result.PartSize)
+	assert.Len(t, result.Parts, 5)
+	assert.Equal(t, int32(5), result.Parts[4].PartNumber)
+	assert.Equal(t, int64(100), result.Parts[4].Size)
+	mockS3.AssertNumberOfCalls(t, "GeneratePresignedPartURL", 5)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateUploadURLUseCase_Execute_FileTooLarge(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	useCase := NewCreateUploadURLUseCase(mockRepo, mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CreateUploadURLCommand{
+		UserID:   1,
+		Filename: "test.mp4",
+		FileSize: maxFileSize + 1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCompleteUploadUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	video := newAwaitingVideo(int64(len(content)))
+
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).
+		Return([]s3.ObjectInfo{{Key: video.OriginalPath, Size: int64(len(content))}}, nil)
+	mockS3.On("GetObject", ctx, "", video.OriginalPath).Return(io.NopCloser(bytes.NewReader(content)), nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
+	mockRepo.On("MarkUploaded", ctx, mock.MatchedBy(func(v *entities.Video) bool {
+		return v.Priority == priorityLow
+	})).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.MatchedBy(func(message map[string]interface{}) bool {
+		return message["video_id"] == video.ID.String() && message["s3_key"] == video.OriginalPath
+	}), mock.Anything).Return(nil)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, video.ID, result.VideoID)
+	assert.Equal(t, "PENDING", result.Status)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestCompleteUploadUseCase_Execute_AssemblesMultipartUpload(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	video := newAwaitingVideo(int64(len(content)))
+	multipartID := "multipart-1"
+	video.MultipartUploadID = &multipartID
+
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).Return([]s3.ObjectInfo{}, nil).Once()
+	mockS3.On("CompleteMultipartUpload", ctx, "", video.OriginalPath, "multipart-1").Return(nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).
+		Return([]s3.ObjectInfo{{Key: video.OriginalPath, Size: int64(len(content))}}, nil).Once()
+	mockS3.On("GetObject", ctx, "", video.OriginalPath).Return(io.NopCloser(bytes.NewReader(content)), nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
+	mockRepo.On("MarkUploaded", ctx, video).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(nil)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+	mockS3.AssertExpectations(t)
+}
+
+func TestCompleteUploadUseCase_Execute_ObjectMissing(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	video := newAwaitingVideo(1024)
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).Return([]s3.ObjectInfo{}, nil)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, new(MockPublisher), DispatchPolicy{})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrObjectMissing)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "MarkUploaded", mock.Anything, mock.Anything)
+}
+
+func TestCompleteUploadUseCase_Execute_SizeMismatch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	video := newAwaitingVideo(1024)
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).
+		Return([]s3.ObjectInfo{{Key: video.OriginalPath, Size: 512}}, nil)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, new(MockPublisher), DispatchPolicy{})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrSizeMismatch)
+}
+
+func TestCompleteUploadUseCase_Execute_ContentMismatch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	content := videoContent("test.avi")
+	video := newAwaitingVideo(int64(len(content)))
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).
+		Return([]s3.ObjectInfo{{Key: video.OriginalPath, Size: int64(len(content))}}, nil)
+	mockS3.On("GetObject", ctx, "", video.OriginalPath).Return(io.NopCloser(bytes.NewReader(content)), nil)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, new(MockPublisher), DispatchPolicy{})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrContentMismatch)
+}
+
+func TestCompleteUploadUseCase_Execute_AlreadyConfirmed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+
+	video := newAwaitingVideo(1024)
+	video.AwaitingUpload = false
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, new(MockPublisher), DispatchPolicy{})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUploadConfirmed)
+	mockS3.AssertNotCalled(t, "ListObjectDetails", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCompleteUploadUseCase_Execute_ConcurrentConfirmation(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	video := newAwaitingVideo(int64(len(content)))
+
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).
+		Return([]s3.ObjectInfo{{Key: video.OriginalPath, Size: int64(len(content))}}, nil)
+	mockS3.On("GetObject", ctx, "", video.OriginalPath).Return(io.NopCloser(bytes.NewReader(content)), nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
+	mockRepo.On("MarkUploaded", ctx, video).Return(repositories.ErrUploadConfirmed)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUploadConfirmed)
+	mockPublisher.AssertNotCalled(t, "PublishWithOptions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCompleteUploadUseCase_Execute_PublishFailureReopensUpload(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	video := newAwaitingVideo(int64(len(content)))
+	uploadExpiry := video.ExpiresAt
+
+	mockRepo.On("FindByID", ctx, video.ID).Return(video, nil)
+	mockS3.On("ListObjectDetails", ctx, "", video.OriginalPath).
+		Return([]s3.ObjectInfo{{Key: video.OriginalPath, Size: int64(len(content))}}, nil)
+	mockS3.On("GetObject", ctx, "", video.OriginalPath).Return(io.NopCloser(bytes.NewReader(content)), nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
+	mockRepo.On("MarkUploaded", ctx, video).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).
+		Return(errors.New("broker unavailable"))
+	mockRepo.On("ReopenUpload", ctx, mock.MatchedBy(func(v *entities.Video) bool {
+		return v.ID == video.ID && v.ExpiresAt.Equal(uploadExpiry)
+	})).Return(nil)
+
+	useCase := NewCompleteUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CompleteUploadCommand{VideoID: video.ID, UserID: 1})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to queue processing job")
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,45 @@
+package upload
+
+import "slices"
+
+// Priorities of processing jobs on the queue. Standard users can ask for up
+// to normal and premium users for up to high.
+const (
+	priorityLow    = 2
+	priorityNormal = 5
+	priorityHigh   = 8
+)
+
+var allowedPriorities = map[string]uint8{
+	"low":    priorityLow,
+	"normal": priorityNormal,
+	"high":   priorityHigh,
+}
+
+// DispatchPolicy decides the queue priority of processing jobs.
+type DispatchPolicy struct {
+	PremiumUserIDs []int64
+	UserJobLimit   int
+}
+
+// priority returns the queue priority of a new job. It starts from the
+// requested priority, capped by the user's tier, and drops one level for
+// every UserJobLimit jobs the user already has queued or running, so a bulk
+// upload is interleaved with other users' jobs instead of holding the queue.
+func (p DispatchPolicy) priority(userID int64, requested string, active int64) uint8 {
+	ceiling := uint8(priorityNormal)
+	if slices.Contains(p.PremiumUserIDs, userID) {
+		ceiling = priorityHigh
+	}
+
+	priority := ceiling
+	if requested != "" {
+		priority = min(allowedPriorities[requested], ceiling)
+	}
+
+	backlog := active / int64(max(p.UserJobLimit, 1))
+	if backlog >= int64(priority) {
+		return 0
+	}
+	return priority - uint8(backlog)
+}
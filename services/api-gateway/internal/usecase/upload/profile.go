@@ -0,0 +1,39 @@
+package upload
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+// optionsProfile names the optional processing stages an option set turns
+// on, so completion estimates can be based on videos that went through the
+// same work. Options that only tune a stage leave the profile unchanged.
+// An HLS request without renditions gets the worker's three defaults.
+func optionsProfile(opts commands.ProcessingOptions) string {
+	var stages []string
+	add := func(enabled bool, stage string) {
+		if enabled {
+			stages = append(stages, stage)
+		}
+	}
+
+	add(opts.Preview.Format == "mp4", "preview:mp4")
+	add(opts.Audio.Format != "", "audio")
+	add(opts.Dedupe.Enabled, "dedupe")
+	add(opts.Quality.Enabled, "quality")
+	add(opts.Shots.Enabled, "shots")
+	add(opts.Activity.Enabled, "activity")
+	add(opts.Colors.Enabled, "colors")
+	add(opts.Watermark.AssetID != "" || opts.Watermark.Text != "", "watermark")
+	if opts.HLS.Enabled {
+		stages = append(stages, fmt.Sprintf("hls:%d", cmp.Or(len(opts.HLS.Renditions), 3)))
+	}
+
+	if len(stages) == 0 {
+		return "frames"
+	}
+	return strings.Join(stages, ",")
+}
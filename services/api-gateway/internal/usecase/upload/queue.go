@@ -0,0 +1,91 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+)
+
+// storedUpload is a source file that reached S3 and awaits processing.
+type storedUpload struct {
+	VideoID  uuid.UUID
+	UserID   int64
+	Filename string
+	S3Key    string
+	FileSize int64
+	Options  commands.ProcessingOptions
+}
+
+// processingQueue records uploads as pending videos and queues their
+// processing jobs, whichever way the file arrived.
+type processingQueue struct {
+	videoRepo repositories.VideoRepository
+	publisher rabbitmq.Publisher
+	dispatch  DispatchPolicy
+}
+
+func (q processingQueue) enqueue(ctx context.Context, upload storedUpload) error {
+	video := newPendingVideo(upload)
+	if err := q.prioritize(ctx, video, upload.Options); err != nil {
+		return err
+	}
+
+	if err := q.videoRepo.Create(ctx, video); err != nil {
+		return fmt.Errorf("failed to create video record: %w", err)
+	}
+
+	return q.publish(ctx, video, upload.Options)
+}
+
+func newPendingVideo(upload storedUpload) *entities.Video {
+	profile := optionsProfile(upload.Options)
+	return &entities.Video{
+		ID:             upload.VideoID,
+		UserID:         upload.UserID,
+		Filename:       upload.Filename,
+		OriginalPath:   upload.S3Key,
+		Status:         entities.StatusPending,
+		FPS:            1,
+		FileSize:       &upload.FileSize,
+		OptionsProfile: &profile,
+		CreatedAt:      time.Now(),
+		ExpiresAt:      time.Now().Add(retention),
+	}
+}
+
+// prioritize sets the queue priority of the video from the user's tier and
+// the jobs they already have queued or running.
+func (q processingQueue) prioritize(ctx context.Context, video *entities.Video, opts commands.ProcessingOptions) error {
+	active, err := q.videoRepo.CountActiveByUserID(ctx, video.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to count active videos: %w", err)
+	}
+
+	video.Priority = int(q.dispatch.priority(video.UserID, opts.Priority, active))
+	return nil
+}
+
+func (q processingQueue) publish(ctx context.Context, video *entities.Video, opts commands.ProcessingOptions) error {
+	priority := uint8(video.Priority)
+	jobMessage := map[string]interface{}{
+		"video_id":  video.ID.String(),
+		"user_id":   video.UserID,
+		"s3_key":    video.OriginalPath,
+		"filename":  video.Filename,
+		"file_size": *video.FileSize,
+		"options":   opts,
+		"priority":  priority,
+	}
+
+	if err := q.publisher.PublishWithOptions(ctx, "video.processing.priority", jobMessage, rabbitmq.PublishOptions{Priority: priority}); err != nil {
+		return fmt.Errorf("failed to queue processing job: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package upload
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+const (
+	containerMP4      = "mp4"
+	containerMatroska = "matroska"
+	containerAVI      = "avi"
+)
+
+// sniffLen covers the longest signature checked.
+const sniffLen = 12
+
+var extensionContainers = map[string]string{
+	".mp4":  containerMP4,
+	".mov":  containerMP4,
+	".mkv":  containerMatroska,
+	".webm": containerMatroska,
+	".avi":  containerAVI,
+}
+
+// isoBoxes are the box types an MP4 or QuickTime file may open with. Older
+// QuickTime files skip ftyp and start with the movie or a padding box.
+var isoBoxes = map[string]bool{
+	"ftyp": true,
+	"moov": true,
+	"mdat": true,
+	"wide": true,
+	"free": true,
+	"skip": true,
+}
+
+var matroskaMagic = []byte{0x1A, 0x45, 0xDF, 0xA3}
+
+// sniffContent reads the head of the stream, checks that it is the container
+// the extension claims, and returns a reader that replays it ahead of the rest.
+func sniffContent(r io.Reader, ext string) (io.Reader, error) {
+	header := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	header = header[:n]
+
+	if err := checkContainer(header, ext); err != nil {
+		return nil, err
+	}
+
+	return io.MultiReader(bytes.NewReader(header), r), nil
+}
+
+// checkContainer checks that the head of a file, up to sniffLen bytes, is
+// the container the extension claims.
+func checkContainer(header []byte, ext string) error {
+	container := detectContainer(header)
+	if container == "" {
+		return ErrUnrecognizedContent
+	}
+	if container != extensionContainers[ext] {
+		return fmt.Errorf("%w: %s", ErrContentMismatch, ext)
+	}
+	return nil
+}
+
+func detectContainer(header []byte) string {
+	switch {
+	case len(header) >= 8 && isoBoxes[string(header[4:8])]:
+		return containerMP4
+	case bytes.HasPrefix(header, matroskaMagic):
+		return containerMatroska
+	case len(header) >= 12 && string(header[:4]) == "RIFF" && string(header[8:12]) == "AVI ":
+		return containerAVI
+	}
+	return ""
+}
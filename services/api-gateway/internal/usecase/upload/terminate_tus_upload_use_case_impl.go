@@ -0,0 +1,45 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type terminateTusUploadUseCaseImpl struct {
+	tusRepo  repositories.TusUploadRepository
+	s3Client s3.S3Client
+}
+
+func NewTerminateTusUploadUseCase(
+	tusRepo repositories.TusUploadRepository,
+	s3Client s3.S3Client,
+) TerminateTusUploadUseCase {
+	return &terminateTusUploadUseCaseImpl{
+		tusRepo:  tusRepo,
+		s3Client: s3Client,
+	}
+}
+
+// Execute discards the parts received so far. Terminating a completed
+// upload only forgets it; the queued video is left alone.
+func (uc *terminateTusUploadUseCaseImpl) Execute(ctx context.Context, cmd commands.TerminateTusUploadCommand) error {
+	upload, err := findTusUpload(ctx, uc.tusRepo, cmd.UploadID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if upload.VideoID == nil {
+		if err := uc.s3Client.AbortMultipartUpload(ctx, "", upload.S3Key, upload.MultipartID); err != nil {
+			return fmt.Errorf("failed to abort upload: %w", err)
+		}
+	}
+
+	if err := uc.tusRepo.Delete(ctx, upload.ID); err != nil {
+		return fmt.Errorf("failed to delete upload record: %w", err)
+	}
+	return nil
+}
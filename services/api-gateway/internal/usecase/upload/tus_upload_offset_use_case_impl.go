@@ -0,0 +1,24 @@
+package upload
+
+import (
+	"context"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type tusUploadOffsetUseCaseImpl struct {
+	tusRepo repositories.TusUploadRepository
+}
+
+func NewTusUploadOffsetUseCase(tusRepo repositories.TusUploadRepository) TusUploadOffsetUseCase {
+	return &tusUploadOffsetUseCaseImpl{tusRepo: tusRepo}
+}
+
+func (uc *tusUploadOffsetUseCaseImpl) Execute(ctx context.Context, cmd commands.TusUploadOffsetCommand) (*TusUploadOutput, error) {
+	upload, err := findTusUpload(ctx, uc.tusRepo, cmd.UploadID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return tusOutput(upload), nil
+}
@@ -0,0 +1,81 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+// tusExpiry is how long a resumable upload can take from its creation.
+const tusExpiry = 24 * time.Hour
+
+// MaxTusUploadSize is the largest upload a client may announce.
+const MaxTusUploadSize = maxFileSize
+
+var (
+	ErrUploadNotFound = errors.New("upload not found")
+	ErrUploadExpired  = errors.New("upload expired")
+	ErrOffsetMismatch = errors.New("upload offset does not match")
+)
+
+// TusUploadOutput describes a resumable upload. VideoID is set once the
+// upload is complete and the video was queued.
+type TusUploadOutput struct {
+	ID        uuid.UUID
+	Length    int64
+	Offset    int64
+	ExpiresAt time.Time
+	VideoID   *uuid.UUID
+}
+
+type CreateTusUploadUseCase interface {
+	Execute(ctx context.Context, cmd commands.CreateTusUploadCommand) (*TusUploadOutput, error)
+}
+
+type TusUploadOffsetUseCase interface {
+	Execute(ctx context.Context, cmd commands.TusUploadOffsetCommand) (*TusUploadOutput, error)
+}
+
+type WriteTusChunkUseCase interface {
+	Execute(ctx context.Context, cmd commands.WriteTusChunkCommand) (*TusUploadOutput, error)
+}
+
+type TerminateTusUploadUseCase interface {
+	Execute(ctx context.Context, cmd commands.TerminateTusUploadCommand) error
+}
+
+// findTusUpload loads an upload of the user. Uploads of other users are
+// reported as missing.
+func findTusUpload(ctx context.Context, tusRepo repositories.TusUploadRepository, id uuid.UUID, userID int64) (*entities.TusUpload, error) {
+	upload, err := tusRepo.FindByID(ctx, id)
+	if errors.Is(err, repositories.ErrTusUploadNotFound) {
+		return nil, ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find upload: %w", err)
+	}
+
+	if upload.UserID != userID {
+		return nil, ErrUploadNotFound
+	}
+	if time.Now().After(upload.ExpiresAt) {
+		return nil, ErrUploadExpired
+	}
+	return upload, nil
+}
+
+func tusOutput(upload *entities.TusUpload) *TusUploadOutput {
+	return &TusUploadOutput{
+		ID:        upload.ID,
+		Length:    upload.Length,
+		Offset:    upload.Offset,
+		ExpiresAt: upload.ExpiresAt,
+		VideoID:   upload.VideoID,
+	}
+}
@@ -0,0 +1,474 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockTusUploadRepository struct {
+	mock.Mock
+}
+
+func (m *MockTusUploadRepository) Create(ctx context.Context, upload *entities.TusUpload) error {
+	args := m.Called(ctx, upload)
+	return args.Error(0)
+}
+
+func (m *MockTusUploadRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.TusUpload, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TusUpload), args.Error(1)
+}
+
+func (m *MockTusUploadRepository) UpdateProgress(ctx context.Context, upload *entities.TusUpload, previousOffset int64) error {
+	args := m.Called(ctx, upload, previousOffset)
+	return args.Error(0)
+}
+
+func (m *MockTusUploadRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func newTusUpload(length int64) *entities.TusUpload {
+	id := uuid.New()
+	return &entities.TusUpload{
+		ID:          id,
+		UserID:      1,
+		Filename:    "test.mp4",
+		Length:      length,
+		S3Key:       uploadKey(id, "test.mp4"),
+		MultipartID: "multipart-1",
+		Options:     "{}",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+}
+
+func TestCreateTusUploadUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockS3 := new(MockS3Client)
+
+	var created *entities.TusUpload
+	mockS3.On("CreateMultipartUpload", ctx, "", mock.AnythingOfType("string")).Return("multipart-1", nil)
+	mockTusRepo.On("Create", ctx, mock.AnythingOfType("*entities.TusUpload")).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*entities.TusUpload)
+	}).Return(nil)
+
+	useCase := NewCreateTusUploadUseCase(mockTusRepo, mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CreateTusUploadCommand{
+		UserID:   1,
+		Filename: "clips/test.mp4",
+		Length:   1024,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), result.Offset)
+	assert.Equal(t, int64(1024), result.Length)
+	assert.Nil(t, result.VideoID)
+	assert.Equal(t, result.ID, created.ID)
+	assert.Equal(t, "test.mp4", created.Filename)
+	assert.Equal(t, "uploads/"+result.ID.String()+"/test.mp4", created.S3Key)
+	assert.Equal(t, "multipart-1", created.MultipartID)
+	mockS3.AssertExpectations(t)
+}
+
+func TestCreateTusUploadUseCase_Execute_InvalidExtension(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockS3 := new(MockS3Client)
+
+	useCase := NewCreateTusUploadUseCase(mockTusRepo, mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.CreateTusUploadCommand{
+		UserID:   1,
+		Filename: "test.txt",
+		Length:   1024,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockS3.AssertNotCalled(t, "CreateMultipartUpload", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWriteTusChunkUseCase_Execute_KeepsPartialChunkAsTail(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	upload := newTusUpload(1024)
+
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockTusRepo.On("UpdateProgress", mock.Anything, mock.MatchedBy(func(u *entities.TusUpload) bool {
+		return u.Offset == int64(len(content)) && bytes.Equal(u.Tail, content) && u.PartCount == 0
+	}), int64(0)).Return(nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Body:     bytes.NewReader(content),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), result.Offset)
+	assert.Nil(t, result.VideoID)
+	mockTusRepo.AssertExpectations(t)
+	mockS3.AssertNotCalled(t, "UploadPart", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWriteTusChunkUseCase_Execute_UploadsFullParts(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := append(videoContent("test.mp4"), make([]byte, s3.MinPartSize)...)
+	upload := newTusUpload(int64(len(content)) + 100)
+
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockS3.On("UploadPart", ctx, "", upload.S3Key, "multipart-1", int32(1), mock.MatchedBy(func(data []byte) bool {
+		return bytes.Equal(data, content[:s3.MinPartSize])
+	})).Return(nil)
+	mockTusRepo.On("UpdateProgress", mock.Anything, mock.MatchedBy(func(u *entities.TusUpload) bool {
+		return u.PartCount == 1 && bytes.Equal(u.Tail, content[s3.MinPartSize:])
+	}), int64(0)).Return(nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Body:     bytes.NewReader(content),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), result.Offset)
+	mockS3.AssertExpectations(t)
+	mockTusRepo.AssertExpectations(t)
+}
+
+func TestWriteTusChunkUseCase_Execute_LastChunkQueuesVideo(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	upload := newTusUpload(int64(len(content)))
+	upload.Offset = 10
+	upload.Tail = content[:10]
+
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockS3.On("ListObjectDetails", ctx, "", upload.S3Key).Return([]s3.ObjectInfo{}, nil)
+	mockS3.On("UploadPart", ctx, "", upload.S3Key, "multipart-1", int32(1), mock.MatchedBy(func(data []byte) bool {
+		return bytes.Equal(data, content)
+	})).Return(nil)
+	mockS3.On("CompleteMultipartUpload", ctx, "", upload.S3Key, "multipart-1").Return(nil)
+	mockRepo.On("FindByID", ctx, upload.ID).Return(nil, errors.New("video not found"))
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(video *entities.Video) bool {
+		return video.ID == upload.ID && video.OriginalPath == upload.S3Key && *video.FileSize == int64(len(content))
+	})).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(nil)
+	mockTusRepo.On("UpdateProgress", ctx, mock.MatchedBy(func(u *entities.TusUpload) bool {
+		return u.VideoID != nil && *u.VideoID == upload.ID && u.Tail == nil
+	}), int64(10)).Return(nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Offset:   10,
+		Body:     bytes.NewReader(content[10:]),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), result.Offset)
+	assert.Equal(t, upload.ID, *result.VideoID)
+	mockS3.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+	mockTusRepo.AssertExpectations(t)
+}
+
+func TestWriteTusChunkUseCase_Execute_RetriedLastChunkAfterEnqueueFailure(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	upload := newTusUpload(int64(len(content)))
+	upload.Offset = 10
+	upload.Tail = content[:10]
+
+	// An earlier attempt assembled the object but failed to queue the video.
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockS3.On("ListObjectDetails", ctx, "", upload.S3Key).
+		Return([]s3.ObjectInfo{{Key: upload.S3Key, Size: int64(len(content))}}, nil)
+	mockRepo.On("FindByID", ctx, upload.ID).Return(nil, errors.New("video not found"))
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
+	mockRepo.On("Create", ctx, mock.Anything).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(nil)
+	mockTusRepo.On("UpdateProgress", ctx, mock.Anything, int64(10)).Return(nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Offset:   10,
+		Body:     bytes.NewReader(content[10:]),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, upload.ID, *result.VideoID)
+	mockS3.AssertNotCalled(t, "UploadPart", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockS3.AssertNotCalled(t, "CompleteMultipartUpload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestWriteTusChunkUseCase_Execute_RetriedLastChunkAfterSaveFailure(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	content := videoContent("test.mp4")
+	upload := newTusUpload(int64(len(content)))
+	upload.Offset = 10
+	upload.Tail = content[:10]
+
+	// An earlier attempt recorded the video but failed to save the upload.
+	video := &entities.Video{ID: upload.ID, UserID: 1, OriginalPath: upload.S3Key, FileSize: &upload.Length, Priority: 5}
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockS3.On("ListObjectDetails", ctx, "", upload.S3Key).
+		Return([]s3.ObjectInfo{{Key: upload.S3Key, Size: int64(len(content))}}, nil)
+	mockRepo.On("FindByID", ctx, upload.ID).Return(video, nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(nil)
+	mockTusRepo.On("UpdateProgress", ctx, mock.MatchedBy(func(u *entities.TusUpload) bool {
+		return u.VideoID != nil && *u.VideoID == upload.ID
+	}), int64(10)).Return(nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Offset:   10,
+		Body:     bytes.NewReader(content[10:]),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockPublisher.AssertExpectations(t)
+	mockTusRepo.AssertExpectations(t)
+}
+
+func TestWriteTusChunkUseCase_Execute_OffsetMismatch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockS3 := new(MockS3Client)
+
+	upload := newTusUpload(1024)
+	upload.Offset = 100
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, new(MockVideoRepository), mockS3, new(MockPublisher), DispatchPolicy{})
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Offset:   50,
+		Body:     bytes.NewReader([]byte("data")),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrOffsetMismatch)
+	assert.Nil(t, result)
+}
+
+func TestWriteTusChunkUseCase_Execute_ConcurrentWriteConflicts(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+
+	upload := newTusUpload(1024)
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockTusRepo.On("UpdateProgress", mock.Anything, upload, int64(0)).Return(repositories.ErrTusUploadConflict)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, new(MockVideoRepository), new(MockS3Client), new(MockPublisher), DispatchPolicy{})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Body:     bytes.NewReader(videoContent("test.mp4")),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrOffsetMismatch)
+}
+
+func TestWriteTusChunkUseCase_Execute_ContentMismatchDiscardsUpload(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockS3 := new(MockS3Client)
+
+	upload := newTusUpload(1024)
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockS3.On("AbortMultipartUpload", ctx, "", upload.S3Key, "multipart-1").Return(nil)
+	mockTusRepo.On("Delete", ctx, upload.ID).Return(nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, new(MockVideoRepository), mockS3, new(MockPublisher), DispatchPolicy{})
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Body:     bytes.NewReader(videoContent("test.mkv")),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrContentMismatch)
+	mockS3.AssertExpectations(t)
+	mockTusRepo.AssertExpectations(t)
+}
+
+func TestWriteTusChunkUseCase_Execute_BrokenConnectionKeepsProgress(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+
+	content := videoContent("test.mp4")
+	upload := newTusUpload(1024)
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockTusRepo.On("UpdateProgress", mock.Anything, mock.MatchedBy(func(u *entities.TusUpload) bool {
+		return u.Offset == int64(len(content)) && bytes.Equal(u.Tail, content)
+	}), int64(0)).Return(nil)
+
+	useCase := NewWriteTusChunkUseCase(mockTusRepo, new(MockVideoRepository), new(MockS3Client), new(MockPublisher), DispatchPolicy{})
+
+	body := io.MultiReader(bytes.NewReader(content), iotest.ErrReader(errors.New("connection reset")))
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.WriteTusChunkCommand{
+		UploadID: upload.ID,
+		UserID:   1,
+		Body:     body,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockTusRepo.AssertExpectations(t)
+}
+
+func TestTusUploadOffsetUseCase_Execute_OtherUser(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+
+	upload := newTusUpload(1024)
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+
+	useCase := NewTusUploadOffsetUseCase(mockTusRepo)
+
+	// Act
+	result, err := useCase.Execute(ctx, commands.TusUploadOffsetCommand{UploadID: upload.ID, UserID: 2})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+	assert.Nil(t, result)
+}
+
+func TestTusUploadOffsetUseCase_Execute_Expired(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+
+	upload := newTusUpload(1024)
+	upload.ExpiresAt = time.Now().Add(-time.Minute)
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+
+	useCase := NewTusUploadOffsetUseCase(mockTusRepo)
+
+	// Act
+	_, err := useCase.Execute(ctx, commands.TusUploadOffsetCommand{UploadID: upload.ID, UserID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUploadExpired)
+}
+
+func TestTerminateTusUploadUseCase_Execute_AbortsMultipartUpload(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTusRepo := new(MockTusUploadRepository)
+	mockS3 := new(MockS3Client)
+
+	upload := newTusUpload(1024)
+	mockTusRepo.On("FindByID", ctx, upload.ID).Return(upload, nil)
+	mockS3.On("AbortMultipartUpload", ctx, "", upload.S3Key, "multipart-1").Return(nil)
+	mockTusRepo.On("Delete", ctx, upload.ID).Return(nil)
+
+	useCase := NewTerminateTusUploadUseCase(mockTusRepo, mockS3)
+
+	// Act
+	err := useCase.Execute(ctx, commands.TerminateTusUploadCommand{UploadID: upload.ID, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+	mockS3.AssertExpectations(t)
+	mockTusRepo.AssertExpectations(t)
+}
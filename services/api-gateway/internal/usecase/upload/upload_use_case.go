@@ -2,11 +2,17 @@ package upload
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
 )
 
+var (
+	ErrUnrecognizedContent = errors.New("file content is not a recognized video container")
+	ErrContentMismatch     = errors.New("file content does not match its extension")
+)
+
 type UploadOutput struct {
 	VideoID  uuid.UUID
 	Filename string
@@ -12,6 +12,7 @@ import (
 	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/services/api-gateway/internal/usecase/watermark"
 	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
 	"github.com/video-platform/shared/pkg/storage/s3"
 )
@@ -19,8 +20,75 @@ import (
 const (
 	maxFileSize = 500 * 1024 * 1024
 	retention   = 15 * 24 * time.Hour
+
+	maxMosaicGrid        = 20
+	minMosaicThumbWidth  = 32
+	maxMosaicThumbWidth  = 1920
+	minMosaicThumbHeight = 18
+	maxMosaicThumbHeight = 1080
+	maxMosaicSheetSide   = 8192
+	maxPreviewDuration   = 10
+	minPreviewWidth      = 64
+	maxPreviewWidth      = 640
+	maxDedupeDistance    = 32
+	maxLuminance         = 255
+	maxActivityPadding   = 60
+	maxDominantColors    = 16
+	maxHLSRenditions     = 5
+	maxWatermarkText     = 200
+	minWatermarkFontSize = 8
+	maxWatermarkFontSize = 200
+)
+
+// The worker's contact sheet defaults, used to size the sheet when options
+// are left unset.
+const (
+	defaultMosaicColumns     = 5
+	defaultMosaicRows        = 6
+	defaultMosaicThumbWidth  = 320
+	defaultMosaicThumbHeight = 180
 )
 
+var allowedPreviewFormats = map[string]bool{
+	"gif": true,
+	"mp4": true,
+}
+
+var allowedAudioFormats = map[string]bool{
+	"wav":  true,
+	"flac": true,
+	"mp3":  true,
+}
+
+var allowedHLSRenditions = map[int]bool{
+	240:  true,
+	360:  true,
+	480:  true,
+	720:  true,
+	1080: true,
+}
+
+var allowedWatermarkPositions = map[string]bool{
+	"top-left":     true,
+	"top-right":    true,
+	"bottom-left":  true,
+	"bottom-right": true,
+	"center":       true,
+}
+
+var allowedDeinterlaceModes = map[string]bool{
+	"auto":  true,
+	"off":   true,
+	"yadif": true,
+	"bwdif": true,
+}
+
+var allowedToneMapModes = map[string]bool{
+	"auto": true,
+	"off":  true,
+	"on":   true,
+}
+
 var allowedExtensions = map[string]bool{
 	".mp4":  true,
 	".avi":  true,
@@ -30,59 +98,57 @@ var allowedExtensions = map[string]bool{
 }
 
 type uploadUseCaseImpl struct {
-	videoRepo repositories.VideoRepository
-	s3Client  s3.S3Client
-	publisher rabbitmq.Publisher
+	s3Client s3.S3Client
+	queue    processingQueue
 }
 
 func NewUploadUseCase(
 	videoRepo repositories.VideoRepository,
 	s3Client s3.S3Client,
 	publisher rabbitmq.Publisher,
+	dispatch DispatchPolicy,
 ) UploadUseCase {
 	return &uploadUseCaseImpl{
-		videoRepo: videoRepo,
-		s3Client:  s3Client,
-		publisher: publisher,
+		s3Client: s3Client,
+		queue: processingQueue{
+			videoRepo: videoRepo,
+			publisher: publisher,
+			dispatch:  dispatch,
+		},
 	}
 }
 
 func (uc *uploadUseCaseImpl) Execute(ctx context.Context, cmd commands.UploadCommand) (*UploadOutput, error) {
-	if err := uc.validateFile(cmd); err != nil {
+	if err := validateUpload(cmd.Filename, cmd.FileSize, cmd.Options); err != nil {
 		return nil, err
 	}
 
-	videoID := uuid.New()
-	s3Key := fmt.Sprintf("uploads/%s/%s", videoID.String(), cmd.Filename)
-
-	if err := uc.s3Client.Upload(ctx, "", s3Key, cmd.FileReader); err != nil {
-		return nil, fmt.Errorf("failed to upload to S3: %w", err)
+	reader, err := sniffContent(cmd.FileReader, strings.ToLower(filepath.Ext(cmd.Filename)))
+	if err != nil {
+		return nil, err
 	}
 
-	video := &entities.Video{
-		ID:           videoID,
-		UserID:       cmd.UserID,
-		Filename:     cmd.Filename,
-		OriginalPath: s3Key,
-		Status:       entities.StatusPending,
-		FPS:          1,
-		CreatedAt:    time.Now(),
-		ExpiresAt:    time.Now().Add(retention),
+	if err := checkWatermarkAsset(ctx, uc.s3Client, cmd.UserID, cmd.Options); err != nil {
+		return nil, err
 	}
 
-	if err := uc.videoRepo.Create(ctx, video); err != nil {
-		return nil, fmt.Errorf("failed to create video record: %w", err)
-	}
+	videoID := uuid.New()
+	s3Key := uploadKey(videoID, cmd.Filename)
 
-	jobMessage := map[string]interface{}{
-		"video_id": videoID.String(),
-		"user_id":  cmd.UserID,
-		"s3_key":   s3Key,
-		"filename": cmd.Filename,
+	if err := uc.s3Client.Upload(ctx, "", s3Key, reader); err != nil {
+		return nil, fmt.Errorf("failed to upload to S3: %w", err)
 	}
 
-	if err := uc.publisher.Publish(ctx, "video.processing.queue", jobMessage); err != nil {
-		return nil, fmt.Errorf("failed to queue processing job: %w", err)
+	err = uc.queue.enqueue(ctx, storedUpload{
+		VideoID:  videoID,
+		UserID:   cmd.UserID,
+		Filename: cmd.Filename,
+		S3Key:    s3Key,
+		FileSize: cmd.FileSize,
+		Options:  cmd.Options,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &UploadOutput{
@@ -92,15 +158,190 @@ func (uc *uploadUseCaseImpl) Execute(ctx context.Context, cmd commands.UploadCom
 	}, nil
 }
 
-func (uc *uploadUseCaseImpl) validateFile(cmd commands.UploadCommand) error {
-	if cmd.FileSize > maxFileSize {
+func uploadKey(videoID uuid.UUID, filename string) string {
+	return fmt.Sprintf("uploads/%s/%s", videoID.String(), filename)
+}
+
+func validateUpload(filename string, size int64, opts commands.ProcessingOptions) error {
+	if size > maxFileSize {
 		return errors.New("file size exceeds maximum allowed (500MB)")
 	}
 
-	ext := strings.ToLower(filepath.Ext(cmd.Filename))
+	ext := strings.ToLower(filepath.Ext(filename))
 	if !allowedExtensions[ext] {
 		return fmt.Errorf("file extension %s not allowed", ext)
 	}
 
+	return validateOptions(opts)
+}
+
+func checkWatermarkAsset(ctx context.Context, s3Client s3.S3Client, userID int64, opts commands.ProcessingOptions) error {
+	if assetID := opts.Watermark.AssetID; assetID != "" {
+		keys, err := s3Client.ListObjects(ctx, "", watermark.AssetKey(userID, assetID))
+		if err != nil || len(keys) == 0 {
+			return errors.New("watermark asset not found")
+		}
+	}
+	return nil
+}
+
+func orDefault(value, fallback int) int {
+	if value == 0 {
+		return fallback
+	}
+	return value
+}
+
+func validateOptions(opts commands.ProcessingOptions) error {
+	mosaic := opts.Mosaic
+	if mosaic.Columns < 0 || mosaic.Columns > maxMosaicGrid || mosaic.Rows < 0 || mosaic.Rows > maxMosaicGrid {
+		return fmt.Errorf("contact sheet grid must be at most %dx%d", maxMosaicGrid, maxMosaicGrid)
+	}
+
+	if mosaic.ThumbWidth != 0 && (mosaic.ThumbWidth < minMosaicThumbWidth || mosaic.ThumbWidth > maxMosaicThumbWidth) {
+		return fmt.Errorf("thumbnail width must be between %d and %d", minMosaicThumbWidth, maxMosaicThumbWidth)
+	}
+
+	if mosaic.ThumbHeight != 0 && (mosaic.ThumbHeight < minMosaicThumbHeight || mosaic.ThumbHeight > maxMosaicThumbHeight) {
+		return fmt.Errorf("thumbnail height must be between %d and %d", minMosaicThumbHeight, maxMosaicThumbHeight)
+	}
+
+	// The worker draws the whole sheet in memory, so bound the sheet itself
+	// and not just each of its dimensions.
+	width := orDefault(mosaic.Columns, defaultMosaicColumns) * orDefault(mosaic.ThumbWidth, defaultMosaicThumbWidth)
+	height := orDefault(mosaic.Rows, defaultMosaicRows) * orDefault(mosaic.ThumbHeight, defaultMosaicThumbHeight)
+	if width > maxMosaicSheetSide || height > maxMosaicSheetSide {
+		return fmt.Errorf("contact sheet must be at most %dx%d pixels", maxMosaicSheetSide, maxMosaicSheetSide)
+	}
+
+	preview := opts.Preview
+	if preview.Format != "" && !allowedPreviewFormats[preview.Format] {
+		return errors.New("preview format must be gif or mp4")
+	}
+
+	if preview.Duration < 0 || preview.Duration > maxPreviewDuration {
+		return fmt.Errorf("preview duration must be at most %d seconds", maxPreviewDuration)
+	}
+
+	if preview.Width != 0 && (preview.Width < minPreviewWidth || preview.Width > maxPreviewWidth) {
+		return fmt.Errorf("preview width must be between %d and %d", minPreviewWidth, maxPreviewWidth)
+	}
+
+	// H.264 in yuv420p subsamples chroma, which needs an even width.
+	if preview.Format == "mp4" && preview.Width%2 != 0 {
+		return errors.New("mp4 preview width must be even")
+	}
+
+	audio := opts.Audio
+	if audio.Format != "" && !allowedAudioFormats[audio.Format] {
+		return errors.New("audio format must be wav, flac or mp3")
+	}
+
+	if audio.IncludeInZip && audio.Format == "" {
+		return errors.New("audio format is required to include audio in the archive")
+	}
+
+	if d := opts.Dedupe.MaxDistance; d != nil && (*d < 0 || *d > maxDedupeDistance) {
+		return fmt.Errorf("dedupe max distance must be between 0 and %d", maxDedupeDistance)
+	}
+
+	quality := opts.Quality
+	if l := quality.MinLuminance; l != nil && (*l < 0 || *l > maxLuminance) {
+		return fmt.Errorf("quality min luminance must be between 0 and %d", maxLuminance)
+	}
+
+	if (quality.MinVariance != nil && *quality.MinVariance < 0) || (quality.MinSharpness != nil && *quality.MinSharpness < 0) {
+		return errors.New("quality thresholds must not be negative")
+	}
+
+	if t := opts.Shots.Threshold; t != nil && (*t <= 0 || *t > 1) {
+		return errors.New("shot threshold must be greater than 0 and at most 1")
+	}
+
+	activity := opts.Activity
+	if activity.KeepActiveOnly && !activity.Enabled {
+		return errors.New("activity analysis must be enabled to keep only active frames")
+	}
+
+	if t := activity.Threshold; t != nil && (*t < 0 || *t > 100) {
+		return errors.New("activity threshold must be between 0 and 100")
+	}
+
+	if p := activity.Padding; p != nil && (*p < 0 || *p > maxActivityPadding) {
+		return fmt.Errorf("activity padding must be between 0 and %d seconds", maxActivityPadding)
+	}
+
+	if opts.Colors.Count < 0 || opts.Colors.Count > maxDominantColors {
+		return fmt.Errorf("dominant color count must be at most %d", maxDominantColors)
+	}
+
+	if len(opts.HLS.Renditions) > maxHLSRenditions {
+		return fmt.Errorf("at most %d HLS renditions can be requested", maxHLSRenditions)
+	}
+
+	for _, height := range opts.HLS.Renditions {
+		if !allowedHLSRenditions[height] {
+			return errors.New("HLS renditions must be 240, 360, 480, 720 or 1080")
+		}
+	}
+
+	if _, ok := allowedPriorities[opts.Priority]; opts.Priority != "" && !ok {
+		return errors.New("priority must be low, normal or high")
+	}
+
+	if err := validateVideo(opts.Video); err != nil {
+		return err
+	}
+
+	return validateWatermark(opts.Watermark)
+}
+
+func validateVideo(opts commands.VideoOptions) error {
+	if i := opts.StreamIndex; i != nil && *i < 0 {
+		return errors.New("video stream index must not be negative")
+	}
+
+	if r := opts.Rotation; r != nil && *r != 0 && *r != 90 && *r != 180 && *r != 270 {
+		return errors.New("rotation must be 0, 90, 180 or 270")
+	}
+
+	if opts.Deinterlace != "" && !allowedDeinterlaceModes[opts.Deinterlace] {
+		return errors.New("deinterlace must be auto, off, yadif or bwdif")
+	}
+
+	if opts.ToneMap != "" && !allowedToneMapModes[opts.ToneMap] {
+		return errors.New("tone map must be auto, off or on")
+	}
+
+	return nil
+}
+
+func validateWatermark(opts commands.WatermarkOptions) error {
+	if opts.AssetID != "" && opts.Text != "" {
+		return errors.New("watermark takes either an asset or a text, not both")
+	}
+
+	if opts.AssetID != "" {
+		if _, err := uuid.Parse(opts.AssetID); err != nil {
+			return errors.New("invalid watermark asset ID")
+		}
+	}
+
+	if len(opts.Text) > maxWatermarkText {
+		return fmt.Errorf("watermark text must be at most %d characters", maxWatermarkText)
+	}
+
+	if opts.Position != "" && !allowedWatermarkPositions[opts.Position] {
+		return errors.New("watermark position must be top-left, top-right, bottom-left, bottom-right or center")
+	}
+
+	if o := opts.Opacity; o != nil && (*o <= 0 || *o > 1) {
+		return errors.New("watermark opacity must be greater than 0 and at most 1")
+	}
+
+	if opts.FontSize != 0 && (opts.FontSize < minWatermarkFontSize || opts.FontSize > maxWatermarkFontSize) {
+		return fmt.Errorf("watermark font size must be between %d and %d", minWatermarkFontSize, maxWatermarkFontSize)
+	}
+
 	return nil
 }
@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"io"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +15,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/video-platform/services/api-gateway/internal/domain/entities"
 	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
 type MockVideoRepository struct {
@@ -45,11 +49,44 @@ func (m *MockVideoRepository) CountByUserID(ctx context.Context, userID int64) (
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockVideoRepository) CountActiveByUserID(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountByStatus(ctx context.Context, status entities.VideoStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) CountQueuedAhead(ctx context.Context, video *entities.Video) (int64, error) {
+	args := m.Called(ctx, video)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVideoRepository) FindProcessingStats(ctx context.Context, profile string, limit int) (*entities.ProcessingStats, error) {
+	args := m.Called(ctx, profile, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProcessingStats), args.Error(1)
+}
+
 func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus) error {
 	args := m.Called(ctx, id, status)
 	return args.Error(0)
 }
 
+func (m *MockVideoRepository) MarkUploaded(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) ReopenUpload(ctx context.Context, video *entities.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
 type MockS3Client struct {
 	mock.Mock
 }
@@ -87,6 +124,16 @@ func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key str
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
 	args := m.Called(ctx, bucket, prefix)
 	if args.Get(0) == nil {
@@ -95,6 +142,34 @@ func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) (
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
 type MockPublisher struct {
 	mock.Mock
 }
@@ -104,11 +179,31 @@ func (m *MockPublisher) Publish(ctx context.Context, queue string, message inter
 	return args.Error(0)
 }
 
+func (m *MockPublisher) PublishWithOptions(ctx context.Context, queue string, message interface{}, opts rabbitmq.PublishOptions) error {
+	args := m.Called(ctx, queue, message, opts)
+	return args.Error(0)
+}
+
 func (m *MockPublisher) Close() error {
 	args := m.Called()
 	return args.Error(0)
 }
 
+// videoContent returns a fake file whose head passes content sniffing for
+// the filename's extension.
+func videoContent(filename string) []byte {
+	var header []byte
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".mp4", ".mov":
+		header = []byte("\x00\x00\x00\x18ftypisom")
+	case ".mkv", ".webm":
+		header = []byte{0x1A, 0x45, 0xDF, 0xA3}
+	case ".avi":
+		header = []byte("RIFF\x00\x00\x00\x00AVI ")
+	}
+	return append(header, "fake video content"...)
+}
+
 func TestUploadUseCase_Execute_Success(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
@@ -116,7 +211,7 @@ func TestUploadUseCase_Execute_Success(t *testing.T) {
 	mockS3 := new(MockS3Client)
 	mockPublisher := new(MockPublisher)
 
-	fileContent := []byte("fake video content")
+	fileContent := videoContent("test.mp4")
 	cmd := commands.UploadCommand{
 		UserID:     1,
 		Filename:   "test.mp4",
@@ -127,10 +222,11 @@ func TestUploadUseCase_Execute_Success(t *testing.T) {
 	mockS3.On("Upload", ctx, "", mock.MatchedBy(func(key string) bool {
 		return key != ""
 	}), mock.Anything).Return(nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
 	mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Video")).Return(nil)
-	mockPublisher.On("Publish", ctx, "video.processing.queue", mock.Anything).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(nil)
 
-	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher)
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -161,7 +257,7 @@ func TestUploadUseCase_Execute_FileTooLarge(t *testing.T) {
 		FileReader: nil,
 	}
 
-	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher)
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -186,7 +282,7 @@ func TestUploadUseCase_Execute_InvalidExtension(t *testing.T) {
 		FileReader: nil,
 	}
 
-	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher)
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -205,7 +301,7 @@ func TestUploadUseCase_Execute_S3UploadFailure(t *testing.T) {
 	mockS3 := new(MockS3Client)
 	mockPublisher := new(MockPublisher)
 
-	fileContent := []byte("fake video content")
+	fileContent := videoContent("test.mp4")
 	cmd := commands.UploadCommand{
 		UserID:     1,
 		Filename:   "test.mp4",
@@ -215,7 +311,7 @@ func TestUploadUseCase_Execute_S3UploadFailure(t *testing.T) {
 
 	mockS3.On("Upload", ctx, "", mock.Anything, mock.Anything).Return(errors.New("S3 error"))
 
-	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher)
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -235,7 +331,7 @@ func TestUploadUseCase_Execute_DatabaseError(t *testing.T) {
 	mockS3 := new(MockS3Client)
 	mockPublisher := new(MockPublisher)
 
-	fileContent := []byte("fake video content")
+	fileContent := videoContent("test.mp4")
 	cmd := commands.UploadCommand{
 		UserID:     1,
 		Filename:   "test.mp4",
@@ -244,9 +340,10 @@ func TestUploadUseCase_Execute_DatabaseError(t *testing.T) {
 	}
 
 	mockS3.On("Upload", ctx, "", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
 	mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Video")).Return(errors.New("database error"))
 
-	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher)
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -267,7 +364,7 @@ func TestUploadUseCase_Execute_QueuePublishFailure(t *testing.T) {
 	mockS3 := new(MockS3Client)
 	mockPublisher := new(MockPublisher)
 
-	fileContent := []byte("fake video content")
+	fileContent := videoContent("test.mp4")
 	cmd := commands.UploadCommand{
 		UserID:     1,
 		Filename:   "test.mp4",
@@ -276,10 +373,11 @@ func TestUploadUseCase_Execute_QueuePublishFailure(t *testing.T) {
 	}
 
 	mockS3.On("Upload", ctx, "", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
 	mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Video")).Return(nil)
-	mockPublisher.On("Publish", ctx, "video.processing.queue", mock.Anything).Return(errors.New("queue error"))
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(errors.New("queue error"))
 
-	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher)
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
 
 	// Act
 	result, err := useCase.Execute(ctx, cmd)
@@ -304,7 +402,7 @@ func TestUploadUseCase_Execute_AllowedExtensions(t *testing.T) {
 			mockS3 := new(MockS3Client)
 			mockPublisher := new(MockPublisher)
 
-			fileContent := []byte("fake video content")
+			fileContent := videoContent(filename)
 			cmd := commands.UploadCommand{
 				UserID:     1,
 				Filename:   filename,
@@ -313,10 +411,11 @@ func TestUploadUseCase_Execute_AllowedExtensions(t *testing.T) {
 			}
 
 			mockS3.On("Upload", ctx, "", mock.Anything, mock.Anything).Return(nil)
+			mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
 			mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Video")).Return(nil)
-			mockPublisher.On("Publish", ctx, "video.processing.queue", mock.Anything).Return(nil)
+			mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(nil)
 
-			useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher)
+			useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
 
 			result, err := useCase.Execute(ctx, cmd)
 
@@ -325,3 +424,537 @@ func TestUploadUseCase_Execute_AllowedExtensions(t *testing.T) {
 		})
 	}
 }
+
+func TestUploadUseCase_Execute_InvalidMosaicOptions(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Mosaic: commands.MosaicOptions{Columns: 50},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "contact sheet grid")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_ContactSheetTooLarge(t *testing.T) {
+	tests := []struct {
+		name   string
+		mosaic commands.MosaicOptions
+	}{
+		{name: "large grid of large thumbs", mosaic: commands.MosaicOptions{Columns: 20, Rows: 20, ThumbWidth: 1920, ThumbHeight: 1080}},
+		{name: "wide thumbs in default columns", mosaic: commands.MosaicOptions{ThumbWidth: 1920}},
+		{name: "many rows of default thumbs", mosaic: commands.MosaicOptions{Rows: 20, ThumbHeight: 720}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := new(MockVideoRepository)
+			mockS3 := new(MockS3Client)
+			mockPublisher := new(MockPublisher)
+
+			cmd := commands.UploadCommand{
+				UserID:   1,
+				Filename: "test.mp4",
+				FileSize: 1024,
+				Options:  commands.ProcessingOptions{Mosaic: tt.mosaic},
+			}
+
+			useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+			result, err := useCase.Execute(ctx, cmd)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			assert.Contains(t, err.Error(), "contact sheet must be at most")
+		})
+	}
+
+	// A sheet right at the limit is accepted.
+	assert.NoError(t, validateOptions(commands.ProcessingOptions{
+		Mosaic: commands.MosaicOptions{Columns: 16, Rows: 8, ThumbWidth: 512, ThumbHeight: 1024},
+	}))
+}
+
+func TestUploadUseCase_Execute_InvalidPreviewFormat(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Preview: commands.PreviewOptions{Format: "webp"},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "preview format")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_OddMP4PreviewWidth(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Preview: commands.PreviewOptions{Format: "mp4", Width: 321},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "preview width must be even")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_AudioInZipRequiresFormat(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Audio: commands.AudioOptions{IncludeInZip: true},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "audio format is required")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_InvalidDedupeDistance(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	distance := 64
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Dedupe: commands.DedupeOptions{Enabled: true, MaxDistance: &distance},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "dedupe max distance")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_InvalidQualityThreshold(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	luminance := 300.0
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Quality: commands.QualityOptions{Enabled: true, MinLuminance: &luminance},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "quality min luminance")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_InvalidHLSRendition(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			HLS: commands.HLSOptions{Enabled: true, Renditions: []int{360, 2160}},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "HLS renditions")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_InvalidWatermark(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Watermark: commands.WatermarkOptions{AssetID: uuid.New().String(), Text: "{filename}"},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "either an asset or a text")
+	mockS3.AssertNotCalled(t, "ListObjects", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_WatermarkAssetNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	assetID := uuid.New().String()
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: bytes.NewReader(videoContent("test.mp4")),
+		Options: commands.ProcessingOptions{
+			Watermark: commands.WatermarkOptions{AssetID: assetID},
+		},
+	}
+
+	mockS3.On("ListObjects", ctx, "", "assets/1/watermarks/"+assetID+".png").Return([]string{}, nil)
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "watermark asset not found", err.Error())
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_InvalidRotation(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	rotation := 45
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   1024,
+		FileReader: nil,
+		Options: commands.ProcessingOptions{
+			Video: commands.VideoOptions{Rotation: &rotation},
+		},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "rotation must be")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_UnrecognizedContent(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	fileContent := []byte("#!/bin/sh\necho not a video\n")
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   int64(len(fileContent)),
+		FileReader: bytes.NewReader(fileContent),
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnrecognizedContent)
+	assert.Nil(t, result)
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_ContentMismatch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	fileContent := videoContent("test.avi")
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mkv",
+		FileSize:   int64(len(fileContent)),
+		FileReader: bytes.NewReader(fileContent),
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrContentMismatch)
+	assert.Contains(t, err.Error(), ".mkv")
+	assert.Nil(t, result)
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadUseCase_Execute_UploadsSniffedHeader(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	fileContent := videoContent("test.mov")
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mov",
+		FileSize:   int64(len(fileContent)),
+		FileReader: bytes.NewReader(fileContent),
+	}
+
+	var uploaded []byte
+	mockS3.On("Upload", ctx, "", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		uploaded, _ = io.ReadAll(args.Get(3).(io.Reader))
+	}).Return(nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(0), nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Video")).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.Anything, mock.Anything).Return(nil)
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	_, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fileContent, uploaded)
+}
+
+func TestUploadUseCase_Execute_PublishesWithPriority(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	fileContent := videoContent("test.mp4")
+	cmd := commands.UploadCommand{
+		UserID:     1,
+		Filename:   "test.mp4",
+		FileSize:   int64(len(fileContent)),
+		FileReader: bytes.NewReader(fileContent),
+	}
+
+	mockS3.On("Upload", ctx, "", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("CountActiveByUserID", ctx, int64(1)).Return(int64(4), nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Video")).Return(nil)
+	mockPublisher.On("PublishWithOptions", ctx, "video.processing.priority", mock.MatchedBy(func(message map[string]interface{}) bool {
+		return message["priority"] == uint8(priorityHigh-2)
+	}), rabbitmq.PublishOptions{Priority: priorityHigh - 2}).Return(nil)
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{
+		PremiumUserIDs: []int64{1},
+		UserJobLimit:   2,
+	})
+
+	// Act
+	_, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestUploadUseCase_Execute_InvalidPriority(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockS3 := new(MockS3Client)
+	mockPublisher := new(MockPublisher)
+
+	cmd := commands.UploadCommand{
+		UserID:   1,
+		Filename: "test.mp4",
+		FileSize: 1024,
+		Options:  commands.ProcessingOptions{Priority: "urgent"},
+	}
+
+	useCase := NewUploadUseCase(mockRepo, mockS3, mockPublisher, DispatchPolicy{UserJobLimit: 2})
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "priority must be")
+}
+
+func TestDispatchPolicy_Priority(t *testing.T) {
+	policy := DispatchPolicy{PremiumUserIDs: []int64{7}, UserJobLimit: 2}
+
+	tests := []struct {
+		name      string
+		userID    int64
+		requested string
+		active    int64
+		expected  uint8
+	}{
+		{"standard default", 1, "", 0, priorityNormal},
+		{"standard capped", 1, "high", 0, priorityNormal},
+		{"standard low", 1, "low", 0, priorityLow},
+		{"premium default", 7, "", 0, priorityHigh},
+		{"premium normal", 7, "normal", 0, priorityNormal},
+		{"below job limit", 1, "", 1, priorityNormal},
+		{"backlog demotes", 1, "", 4, priorityNormal - 2},
+		{"backlog floor", 1, "low", 100, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, policy.priority(tt.userID, tt.requested, tt.active))
+		})
+	}
+}
+
+func TestOptionsProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  commands.ProcessingOptions
+		expected string
+	}{
+		{"defaults", commands.ProcessingOptions{}, "frames"},
+		{"tuning only", commands.ProcessingOptions{Mosaic: commands.MosaicOptions{Columns: 4}}, "frames"},
+		{"stages", commands.ProcessingOptions{
+			Shots:     commands.ShotOptions{Enabled: true},
+			Watermark: commands.WatermarkOptions{Text: "{frame}"},
+			HLS:       commands.HLSOptions{Enabled: true},
+		}, "shots,watermark,hls:3"},
+		{"renditions", commands.ProcessingOptions{
+			Preview: commands.PreviewOptions{Format: "mp4"},
+			HLS:     commands.HLSOptions{Enabled: true, Renditions: []int{720}},
+		}, "preview:mp4,hls:1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, optionsProfile(tt.options))
+		})
+	}
+}
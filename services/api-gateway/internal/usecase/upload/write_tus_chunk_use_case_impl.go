@@ -0,0 +1,192 @@
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type writeTusChunkUseCaseImpl struct {
+	tusRepo  repositories.TusUploadRepository
+	s3Client s3.S3Client
+	queue    processingQueue
+}
+
+func NewWriteTusChunkUseCase(
+	tusRepo repositories.TusUploadRepository,
+	videoRepo repositories.VideoRepository,
+	s3Client s3.S3Client,
+	publisher rabbitmq.Publisher,
+	dispatch DispatchPolicy,
+) WriteTusChunkUseCase {
+	return &writeTusChunkUseCaseImpl{
+		tusRepo:  tusRepo,
+		s3Client: s3Client,
+		queue: processingQueue{
+			videoRepo: videoRepo,
+			publisher: publisher,
+			dispatch:  dispatch,
+		},
+	}
+}
+
+// Execute streams the chunk into S3 parts of s3.MinPartSize, keeping the
+// remainder as the upload's tail for the next chunk. The container is
+// checked as soon as the head of the file is in. When the last byte arrives
+// the parts are assembled and the video is queued under the upload's ID.
+func (uc *writeTusChunkUseCaseImpl) Execute(ctx context.Context, cmd commands.WriteTusChunkCommand) (*TusUploadOutput, error) {
+	upload, err := findTusUpload(ctx, uc.tusRepo, cmd.UploadID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Offset != upload.Offset {
+		return nil, ErrOffsetMismatch
+	}
+	if upload.VideoID != nil {
+		return tusOutput(upload), nil
+	}
+
+	previousOffset := upload.Offset
+	sniffed := previousOffset >= sniffLen
+	ext := strings.ToLower(filepath.Ext(upload.Filename))
+	body := io.LimitReader(cmd.Body, upload.Length-upload.Offset)
+
+	buf := make([]byte, len(upload.Tail), s3.MinPartSize)
+	copy(buf, upload.Tail)
+
+	var readErr error
+	for upload.Offset < upload.Length {
+		n, err := io.ReadFull(body, buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+n]
+		upload.Offset += int64(n)
+
+		// No part is uploaded before the head is in, so buf still starts
+		// at the first byte of the file.
+		if !sniffed && (len(buf) >= sniffLen || upload.Offset == upload.Length) {
+			if err := checkContainer(buf[:min(len(buf), sniffLen)], ext); err != nil {
+				uc.discard(ctx, upload)
+				return nil, err
+			}
+			sniffed = true
+		}
+
+		if len(buf) == cap(buf) && upload.Offset < upload.Length {
+			if err := uc.s3Client.UploadPart(ctx, "", upload.S3Key, upload.MultipartID, int32(upload.PartCount+1), buf); err != nil {
+				return nil, fmt.Errorf("failed to store chunk: %w", err)
+			}
+			upload.PartCount++
+			buf = buf[:0]
+		}
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				readErr = err
+			}
+			break
+		}
+	}
+
+	if readErr != nil || upload.Offset < upload.Length {
+		// Keep what arrived, even from a broken connection, so the client
+		// resumes from there.
+		upload.Tail = buf
+		if err := uc.saveProgress(context.WithoutCancel(ctx), upload, previousOffset); err != nil {
+			return nil, err
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read chunk: %w", readErr)
+		}
+		return tusOutput(upload), nil
+	}
+
+	if err := uc.finish(ctx, upload, buf); err != nil {
+		return nil, err
+	}
+
+	upload.Tail = nil
+	upload.VideoID = &upload.ID
+	if err := uc.saveProgress(ctx, upload, previousOffset); err != nil {
+		return nil, err
+	}
+	return tusOutput(upload), nil
+}
+
+// finish uploads the last part, assembles the object and queues the video.
+// It is safe to retry: a client re-sending the last chunk after a failure
+// resumes from whichever step did not complete.
+func (uc *writeTusChunkUseCaseImpl) finish(ctx context.Context, upload *entities.TusUpload, last []byte) error {
+	// Once assembled the multipart upload is gone, so it takes no more parts.
+	object, err := findObject(ctx, uc.s3Client, upload.S3Key)
+	if err != nil {
+		return err
+	}
+
+	if object == nil || object.Size != upload.Length {
+		if len(last) > 0 {
+			if err := uc.s3Client.UploadPart(ctx, "", upload.S3Key, upload.MultipartID, int32(upload.PartCount+1), last); err != nil {
+				return fmt.Errorf("failed to store chunk: %w", err)
+			}
+			upload.PartCount++
+		}
+
+		if err := uc.s3Client.CompleteMultipartUpload(ctx, "", upload.S3Key, upload.MultipartID); err != nil {
+			return fmt.Errorf("failed to complete upload: %w", err)
+		}
+	}
+
+	var options commands.ProcessingOptions
+	if upload.Options != "" {
+		if err := json.Unmarshal([]byte(upload.Options), &options); err != nil {
+			return fmt.Errorf("failed to decode processing options: %w", err)
+		}
+	}
+
+	// The video was recorded by an earlier attempt, which may have failed
+	// to queue it; queueing it again beats leaving it pending forever.
+	if video, err := uc.queue.videoRepo.FindByID(ctx, upload.ID); err == nil {
+		return uc.queue.publish(ctx, video, options)
+	}
+
+	return uc.queue.enqueue(ctx, storedUpload{
+		VideoID:  upload.ID,
+		UserID:   upload.UserID,
+		Filename: upload.Filename,
+		S3Key:    upload.S3Key,
+		FileSize: upload.Length,
+		Options:  options,
+	})
+}
+
+func (uc *writeTusChunkUseCaseImpl) saveProgress(ctx context.Context, upload *entities.TusUpload, previousOffset int64) error {
+	err := uc.tusRepo.UpdateProgress(ctx, upload, previousOffset)
+	if errors.Is(err, repositories.ErrTusUploadConflict) {
+		return ErrOffsetMismatch
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save upload progress: %w", err)
+	}
+	return nil
+}
+
+// discard drops an upload whose content was rejected, so the client stops
+// sending the rest of it.
+func (uc *writeTusChunkUseCaseImpl) discard(ctx context.Context, upload *entities.TusUpload) {
+	if err := uc.s3Client.AbortMultipartUpload(ctx, "", upload.S3Key, upload.MultipartID); err != nil {
+		logging.Warn("Failed to abort multipart upload", "upload_id", upload.ID, "error", err)
+	}
+	if err := uc.tusRepo.Delete(ctx, upload.ID); err != nil {
+		logging.Warn("Failed to delete upload record", "upload_id", upload.ID, "error", err)
+	}
+}
@@ -0,0 +1,25 @@
+package watermark
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type WatermarkOutput struct {
+	AssetID uuid.UUID
+	Width   int
+	Height  int
+}
+
+type UploadWatermarkUseCase interface {
+	Execute(ctx context.Context, cmd commands.UploadWatermarkCommand) (*WatermarkOutput, error)
+}
+
+// AssetKey is where a user's watermark image is stored in the uploads
+// bucket; the worker reads it from the same key.
+func AssetKey(userID int64, assetID string) string {
+	return fmt.Sprintf("assets/%d/watermarks/%s.png", userID, assetID)
+}
@@ -0,0 +1,64 @@
+package watermark
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const (
+	maxWatermarkSize      = 2 * 1024 * 1024
+	maxWatermarkDimension = 2048
+)
+
+type uploadWatermarkUseCaseImpl struct {
+	s3Client s3.S3Client
+}
+
+func NewUploadWatermarkUseCase(s3Client s3.S3Client) UploadWatermarkUseCase {
+	return &uploadWatermarkUseCaseImpl{
+		s3Client: s3Client,
+	}
+}
+
+func (uc *uploadWatermarkUseCaseImpl) Execute(ctx context.Context, cmd commands.UploadWatermarkCommand) (*WatermarkOutput, error) {
+	if cmd.FileSize > maxWatermarkSize {
+		return nil, errors.New("watermark size exceeds maximum allowed (2MB)")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(cmd.FileReader, maxWatermarkSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read watermark: %w", err)
+	}
+
+	if len(data) > maxWatermarkSize {
+		return nil, errors.New("watermark size exceeds maximum allowed (2MB)")
+	}
+
+	config, err := png.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("watermark must be a PNG image")
+	}
+
+	if config.Width > maxWatermarkDimension || config.Height > maxWatermarkDimension {
+		return nil, fmt.Errorf("watermark must be at most %dx%d pixels", maxWatermarkDimension, maxWatermarkDimension)
+	}
+
+	assetID := uuid.New()
+	if err := uc.s3Client.Upload(ctx, "", AssetKey(cmd.UserID, assetID.String()), bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to upload to S3: %w", err)
+	}
+
+	return &WatermarkOutput{
+		AssetID: assetID,
+		Width:   config.Width,
+		Height:  config.Height,
+	}, nil
+}
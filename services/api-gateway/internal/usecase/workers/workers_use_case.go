@@ -0,0 +1,19 @@
+package workers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+var ErrNotAdmin = errors.New("admin access required")
+
+type WorkersOutput struct {
+	Workers []*entities.Worker
+}
+
+type WorkersUseCase interface {
+	Execute(ctx context.Context, cmd commands.WorkersCommand) (*WorkersOutput, error)
+}
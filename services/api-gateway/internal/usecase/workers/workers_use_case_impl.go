@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/video-platform/services/api-gateway/internal/domain/repositories"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type workersUseCaseImpl struct {
+	workerRepo repositories.WorkerRepository
+	admins     []int64
+}
+
+func NewWorkersUseCase(
+	workerRepo repositories.WorkerRepository,
+	admins []int64,
+) WorkersUseCase {
+	return &workersUseCaseImpl{
+		workerRepo: workerRepo,
+		admins:     admins,
+	}
+}
+
+func (uc *workersUseCaseImpl) Execute(ctx context.Context, cmd commands.WorkersCommand) (*WorkersOutput, error) {
+	if !slices.Contains(uc.admins, cmd.UserID) {
+		return nil, ErrNotAdmin
+	}
+
+	workers, err := uc.workerRepo.FindAlive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workers: %w", err)
+	}
+
+	sort.Slice(workers, func(i, j int) bool {
+		if workers[i].Host != workers[j].Host {
+			return workers[i].Host < workers[j].Host
+		}
+		return workers[i].ID < workers[j].ID
+	})
+
+	return &WorkersOutput{Workers: workers}, nil
+}
@@ -0,0 +1,85 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/api-gateway/internal/domain/entities"
+	"github.com/video-platform/services/api-gateway/internal/usecase/commands"
+)
+
+type MockWorkerRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorkerRepository) FindAlive(ctx context.Context) ([]*entities.Worker, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Worker), args.Error(1)
+}
+
+func TestWorkersUseCase_Execute_Success(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockWorkerRepository)
+
+	now := time.Now()
+	mockRepo.On("FindAlive", ctx).Return([]*entities.Worker{
+		{ID: "b", Host: "worker-2", Capacity: 3, HeartbeatAt: now},
+		{ID: "c", Host: "worker-1", Capacity: 3, HeartbeatAt: now, Jobs: []entities.WorkerJob{
+			{Type: "process", ID: "video-1", VideoID: "video-1", UserID: 7, StartedAt: now},
+		}},
+		{ID: "a", Host: "worker-1", Capacity: 3, HeartbeatAt: now},
+	}, nil)
+
+	useCase := NewWorkersUseCase(mockRepo, []int64{1})
+	output, err := useCase.Execute(ctx, commands.WorkersCommand{UserID: 1})
+
+	assert.NoError(t, err)
+	assert.Len(t, output.Workers, 3)
+	assert.Equal(t, "a", output.Workers[0].ID)
+	assert.Equal(t, "c", output.Workers[1].ID)
+	assert.Equal(t, "b", output.Workers[2].ID)
+	assert.Equal(t, "video-1", output.Workers[1].Jobs[0].VideoID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestWorkersUseCase_Execute_NotAdmin(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockWorkerRepository)
+
+	useCase := NewWorkersUseCase(mockRepo, []int64{1})
+	output, err := useCase.Execute(ctx, commands.WorkersCommand{UserID: 2})
+
+	assert.ErrorIs(t, err, ErrNotAdmin)
+	assert.Nil(t, output)
+	mockRepo.AssertNotCalled(t, "FindAlive", mock.Anything)
+}
+
+func TestWorkersUseCase_Execute_NoAdminsConfigured(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockWorkerRepository)
+
+	useCase := NewWorkersUseCase(mockRepo, nil)
+	_, err := useCase.Execute(ctx, commands.WorkersCommand{UserID: 0})
+
+	assert.ErrorIs(t, err, ErrNotAdmin)
+}
+
+func TestWorkersUseCase_Execute_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockWorkerRepository)
+	mockRepo.On("FindAlive", ctx).Return(nil, errors.New("connection refused"))
+
+	useCase := NewWorkersUseCase(mockRepo, []int64{1})
+	output, err := useCase.Execute(ctx, commands.WorkersCommand{UserID: 1})
+
+	assert.Error(t, err)
+	assert.Nil(t, output)
+	mockRepo.AssertExpectations(t)
+}
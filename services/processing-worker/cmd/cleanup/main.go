@@ -50,6 +50,9 @@ func main() {
 	logger.Info("Starting video cleanup job", "dry_run", *dryRun)
 
 	result, err := cleanupUseCase.CleanupExpiredVideos(ctx)
+	if err == nil {
+		err = cleanupUseCase.CleanupExpiredUploads(ctx, result)
+	}
 	duration := time.Since(startTime)
 
 	if err != nil {
@@ -61,6 +64,7 @@ func main() {
 	logger.Info("Cleanup job completed",
 		"videos_deleted", result.VideosDeleted,
 		"s3_objects_deleted", result.S3ObjectsDeleted,
+		"uploads_deleted", result.UploadsDeleted,
 		"duration_seconds", duration.Seconds(),
 		"dry_run", *dryRun,
 	)
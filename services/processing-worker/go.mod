@@ -6,6 +6,8 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/video-platform/shared v0.0.0
 	go.uber.org/fx v1.23.0
+	golang.org/x/image v0.23.0
+	golang.org/x/sys v0.28.0
 	gorm.io/gorm v1.31.1
 )
 
@@ -42,7 +44,6 @@ require (
 	go.uber.org/zap v1.26.0 // indirect
 	golang.org/x/crypto v0.31.0 // indirect
 	golang.org/x/sync v0.10.0 // indirect
-	golang.org/x/sys v0.28.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
 	gorm.io/driver/postgres v1.6.0 // indirect
 )
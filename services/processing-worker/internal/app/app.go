@@ -11,22 +11,45 @@ import (
 
 	"github.com/video-platform/services/processing-worker/internal/controller"
 	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/fairness"
 	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
 	"github.com/video-platform/services/processing-worker/internal/infrastructure/messaging"
 	"github.com/video-platform/services/processing-worker/internal/infrastructure/persistence"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/registry"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/storage"
+	"github.com/video-platform/services/processing-worker/internal/usecase/clip"
 	"github.com/video-platform/services/processing-worker/internal/usecase/process"
+	"github.com/video-platform/services/processing-worker/internal/usecase/render"
 	"github.com/video-platform/shared/pkg/config"
 	"github.com/video-platform/shared/pkg/database/postgres"
+	"github.com/video-platform/shared/pkg/database/redis"
+	"github.com/video-platform/shared/pkg/httpclient"
 	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
 	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
+// workerCapacity is how many jobs a worker runs at once: one from each of
+// the video, render and clip queues.
+const workerCapacity = 3
+
 func InitializeApp() *fx.App {
 	return fx.New(
 		fx.Provide(
 			config.Load,
 			postgres.NewPostgresDB,
 
+			func(cfg *config.Config) (*redis.Client, error) {
+				return redis.NewRedisClient(cfg.RedisURL)
+			},
+
+			func(client *redis.Client, cfg *config.Config) *registry.Registry {
+				return registry.NewRegistry(client, cfg.AppVersion, workerCapacity, cfg.WorkerHeartbeat)
+			},
+
+			func(client *redis.Client, cfg *config.Config) *fairness.Limiter {
+				return fairness.NewLimiter(client, cfg.UserJobLimit, cfg.WorkerHeartbeat)
+			},
+
 			func(cfg *config.Config) (s3.S3Client, error) {
 				return s3.NewS3Client(cfg.AWSRegion, cfg.AWSAccessKeyID, cfg.AWSSecretAccessKey, cfg.S3UploadsBucket)
 			},
@@ -39,39 +62,118 @@ func InitializeApp() *fx.App {
 				return rabbitmq.NewPublisher(cfg.RabbitMQURL)
 			},
 
-			ffmpeg.NewFFmpegService,
+			func(cfg *config.Config) ffmpeg.FFmpegService {
+				return ffmpeg.NewFFmpegService(ffmpeg.ProcessLimits{
+					Nice:       cfg.FFmpegNice,
+					MaxMemory:  uint64(cfg.FFmpegMaxMemoryMB) << 20,
+					MaxCPUTime: cfg.FFmpegMaxCPUTime,
+				})
+			},
+
+			func(cfg *config.Config) storage.StorageClient {
+				return storage.NewStorageClient(
+					httpclient.NewHTTPClient(cfg.HTTPClientTimeout, cfg.HTTPClientRetryCount),
+					cfg.StorageServiceURL,
+				)
+			},
 
 			fx.Annotate(persistence.NewVideoRepository, fx.As(new(repositories.VideoRepository))),
+			fx.Annotate(persistence.NewSubtitleTrackRepository, fx.As(new(repositories.SubtitleTrackRepository))),
+			fx.Annotate(persistence.NewShotRepository, fx.As(new(repositories.ShotRepository))),
+			fx.Annotate(persistence.NewFrameColorRepository, fx.As(new(repositories.FrameColorRepository))),
+			fx.Annotate(persistence.NewRenderRepository, fx.As(new(repositories.RenderRepository))),
+			fx.Annotate(persistence.NewClipRepository, fx.As(new(repositories.ClipRepository))),
+			fx.Annotate(persistence.NewCheckpointRepository, fx.As(new(repositories.CheckpointRepository))),
 
 			func(
 				videoRepo repositories.VideoRepository,
+				subtitleRepo repositories.SubtitleTrackRepository,
+				shotRepo repositories.ShotRepository,
+				colorRepo repositories.FrameColorRepository,
+				checkpointRepo repositories.CheckpointRepository,
 				s3Client s3.S3Client,
 				ffmpegService ffmpeg.FFmpegService,
+				storageClient storage.StorageClient,
 				publisher rabbitmq.Publisher,
 				cfg *config.Config,
 			) process.ProcessUseCase {
-				return process.NewProcessUseCase(videoRepo, s3Client, ffmpegService, publisher, cfg.S3ProcessedBucket)
+				return process.NewProcessUseCase(videoRepo, subtitleRepo, shotRepo, colorRepo, checkpointRepo, s3Client, ffmpegService, storageClient, publisher, cfg.S3ProcessedBucket, process.SourceLimits{
+					MaxDuration:   cfg.MaxVideoDuration,
+					MaxDimension:  cfg.MaxVideoDimension,
+					AllowedCodecs: cfg.AllowedVideoCodecs,
+				}, process.JobLimits{
+					ScratchDir:    cfg.ScratchDir,
+					MinFreeSpace:  uint64(cfg.ScratchMinFreeMB) << 20,
+					TimeoutBase:   cfg.JobTimeoutBase,
+					TimeoutFactor: cfg.JobTimeoutFactor,
+				})
+			},
+
+			func(
+				renderRepo repositories.RenderRepository,
+				s3Client s3.S3Client,
+				ffmpegService ffmpeg.FFmpegService,
+				cfg *config.Config,
+			) render.RenderUseCase {
+				return render.NewRenderUseCase(renderRepo, s3Client, ffmpegService, cfg.S3ProcessedBucket, cfg.ScratchDir)
+			},
+
+			func(
+				clipRepo repositories.ClipRepository,
+				s3Client s3.S3Client,
+				ffmpegService ffmpeg.FFmpegService,
+				cfg *config.Config,
+			) clip.ClipUseCase {
+				return clip.NewClipUseCase(clipRepo, s3Client, ffmpegService, cfg.S3ProcessedBucket, cfg.ScratchDir)
 			},
 
 			fx.Annotate(controller.NewWorkerController, fx.As(new(controller.WorkerController))),
 
-			messaging.NewVideoConsumer,
+			func(
+				consumer *rabbitmq.Consumer,
+				workerController controller.WorkerController,
+				workerRegistry *registry.Registry,
+				limiter *fairness.Limiter,
+				publisher rabbitmq.Publisher,
+				cfg *config.Config,
+			) *messaging.VideoConsumer {
+				return messaging.NewVideoConsumer(consumer, workerController, workerRegistry, limiter, publisher, cfg.JobDeferDelay)
+			},
+			messaging.NewRenderConsumer,
+			messaging.NewClipConsumer,
 		),
 		fx.Invoke(startWorker),
 	)
 }
 
-func startWorker(lc fx.Lifecycle, consumer *messaging.VideoConsumer) {
+func startWorker(
+	lc fx.Lifecycle,
+	consumer *messaging.VideoConsumer,
+	renderConsumer *messaging.RenderConsumer,
+	clipConsumer *messaging.ClipConsumer,
+	workerRegistry *registry.Registry,
+) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	lc.Append(fx.Hook{
 		OnStart: func(_ context.Context) error {
+			go workerRegistry.Run(ctx)
 			go func() {
 				log.Println("Starting Processing Worker")
 				if err := consumer.Start(ctx); err != nil {
 					log.Printf("Worker error: %v", err)
 				}
 			}()
+			go func() {
+				if err := renderConsumer.Start(ctx); err != nil {
+					log.Printf("Render consumer error: %v", err)
+				}
+			}()
+			go func() {
+				if err := clipConsumer.Start(ctx); err != nil {
+					log.Printf("Clip consumer error: %v", err)
+				}
+			}()
 			return nil
 		},
 		OnStop: func(_ context.Context) error {
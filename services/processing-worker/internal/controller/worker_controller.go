@@ -3,24 +3,44 @@ package controller
 import (
 	"context"
 
+	"github.com/video-platform/services/processing-worker/internal/usecase/clip"
 	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
 	"github.com/video-platform/services/processing-worker/internal/usecase/process"
+	"github.com/video-platform/services/processing-worker/internal/usecase/render"
 )
 
 type WorkerController interface {
 	ProcessVideo(ctx context.Context, cmd commands.ProcessCommand) error
+	RenderVideo(ctx context.Context, cmd commands.RenderCommand) error
+	ClipVideo(ctx context.Context, cmd commands.ClipCommand) error
 }
 
 type workerControllerImpl struct {
 	processUseCase process.ProcessUseCase
+	renderUseCase  render.RenderUseCase
+	clipUseCase    clip.ClipUseCase
 }
 
-func NewWorkerController(processUseCase process.ProcessUseCase) WorkerController {
+func NewWorkerController(
+	processUseCase process.ProcessUseCase,
+	renderUseCase render.RenderUseCase,
+	clipUseCase clip.ClipUseCase,
+) WorkerController {
 	return &workerControllerImpl{
 		processUseCase: processUseCase,
+		renderUseCase:  renderUseCase,
+		clipUseCase:    clipUseCase,
 	}
 }
 
 func (c *workerControllerImpl) ProcessVideo(ctx context.Context, cmd commands.ProcessCommand) error {
 	return c.processUseCase.Execute(ctx, cmd)
 }
+
+func (c *workerControllerImpl) RenderVideo(ctx context.Context, cmd commands.RenderCommand) error {
+	return c.renderUseCase.Execute(ctx, cmd)
+}
+
+func (c *workerControllerImpl) ClipVideo(ctx context.Context, cmd commands.ClipCommand) error {
+	return c.clipUseCase.Execute(ctx, cmd)
+}
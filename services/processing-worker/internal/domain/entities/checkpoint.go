@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CheckpointFrame is a frame an earlier processing attempt uploaded, with
+// the size and ETag it was stored under so a retry can verify the object
+// before reusing it.
+type CheckpointFrame struct {
+	VideoID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FrameIndex int       `gorm:"primaryKey"`
+	Segment    int       `gorm:"not null"`
+	Filename   string    `gorm:"type:varchar(255);not null"`
+	Size       int64     `gorm:"not null"`
+	ETag       string    `gorm:"column:etag;type:varchar(64);not null"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (CheckpointFrame) TableName() string {
+	return "videos.checkpoint_frames"
+}
+
+// CheckpointStage is a processing step an earlier attempt completed.
+type CheckpointStage struct {
+	VideoID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Stage       string    `gorm:"type:varchar(50);primaryKey"`
+	CompletedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (CheckpointStage) TableName() string {
+	return "videos.checkpoint_stages"
+}
+
+// Checkpoint is what earlier attempts at processing a video left behind.
+type Checkpoint struct {
+	Frames []*CheckpointFrame
+	Stages []*CheckpointStage
+}
+
+func (c *Checkpoint) HasStage(stage string) bool {
+	for _, s := range c.Stages {
+		if s.Stage == stage {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClipMode records how a clip was cut: stream copied when its start falls on
+// a keyframe, re-encoded otherwise.
+type ClipMode string
+
+const (
+	ClipModeCopy     ClipMode = "COPY"
+	ClipModeReencode ClipMode = "REENCODE"
+)
+
+// Clip is a sub-range of the source video, in seconds from its start.
+type Clip struct {
+	ID           uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	VideoID      uuid.UUID   `gorm:"type:uuid;not null"`
+	UserID       int64       `gorm:"not null"`
+	Index        int         `gorm:"column:clip_index;not null"`
+	StartTime    float64     `gorm:"not null"`
+	EndTime      float64     `gorm:"not null"`
+	Status       VideoStatus `gorm:"type:varchar(20);not null"`
+	Mode         *ClipMode   `gorm:"type:varchar(16)"`
+	OutputPath   *string     `gorm:"type:text"`
+	SizeBytes    *int64      `gorm:"type:bigint"`
+	ErrorMessage *string     `gorm:"type:text"`
+	CreatedAt    time.Time
+	StartedAt    *time.Time
+	CompletedAt  *time.Time
+}
+
+func (Clip) TableName() string {
+	return "videos.clips"
+}
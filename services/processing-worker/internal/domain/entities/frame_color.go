@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FrameColor is one of the dominant colors of an uploaded frame. Rank 1 is
+// the color covering the largest share of the frame.
+type FrameColor struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	VideoID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	FrameIndex int       `gorm:"not null"`
+	Rank       int       `gorm:"not null"`
+	Hex        string    `gorm:"type:varchar(7);not null"`
+	R          int       `gorm:"column:r;not null"`
+	G          int       `gorm:"column:g;not null"`
+	B          int       `gorm:"column:b;not null"`
+	Weight     float64   `gorm:"not null"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (FrameColor) TableName() string {
+	return "videos.frame_colors"
+}
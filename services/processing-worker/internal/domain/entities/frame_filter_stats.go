@@ -0,0 +1,9 @@
+package entities
+
+// FrameFilterStats records how many extracted frames the optional filter
+// stages dropped.
+type FrameFilterStats struct {
+	DuplicateFrames  int
+	LowQualityFrames int
+	InactiveFrames   int
+}
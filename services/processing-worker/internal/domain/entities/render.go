@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Render is a video assembled from the extracted frames of a source video.
+type Render struct {
+	ID           uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	VideoID      uuid.UUID   `gorm:"type:uuid;not null"`
+	UserID       int64       `gorm:"not null"`
+	Format       string      `gorm:"type:varchar(8);not null"`
+	FPS          float64     `gorm:"not null"`
+	Width        int         `gorm:"not null"`
+	Height       int         `gorm:"not null"`
+	Status       VideoStatus `gorm:"type:varchar(20);not null"`
+	FrameCount   *int        `gorm:"type:int"`
+	OutputPath   *string     `gorm:"type:text"`
+	ErrorMessage *string     `gorm:"type:text"`
+	CreatedAt    time.Time
+	StartedAt    *time.Time
+	CompletedAt  *time.Time
+}
+
+func (Render) TableName() string {
+	return "videos.renders"
+}
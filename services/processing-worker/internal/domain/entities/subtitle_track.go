@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SubtitleTrack struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	VideoID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	StreamIndex int       `gorm:"not null"`
+	Language    string    `gorm:"type:varchar(16);not null"`
+	Codec       string    `gorm:"type:varchar(32);not null"`
+	SRTPath     string    `gorm:"type:text;not null"`
+	VTTPath     string    `gorm:"type:text;not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (SubtitleTrack) TableName() string {
+	return "videos.subtitle_tracks"
+}
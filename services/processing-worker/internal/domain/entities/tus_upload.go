@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TusUpload is a resumable upload the gateway assembles in an S3 multipart
+// upload. Only the fields cleanup needs are mapped; VideoID is set once the
+// upload completed.
+type TusUpload struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	S3Key       string     `gorm:"type:text;not null"`
+	MultipartID string     `gorm:"type:text;not null"`
+	VideoID     *uuid.UUID `gorm:"type:uuid"`
+	ExpiresAt   time.Time  `gorm:"type:timestamp;not null"`
+}
+
+func (TusUpload) TableName() string {
+	return "videos.tus_uploads"
+}
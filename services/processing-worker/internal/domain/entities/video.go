@@ -15,20 +15,45 @@ const (
 	StatusFailed     VideoStatus = "FAILED"
 )
 
+// Error codes recorded when a source is rejected before processing.
+const (
+	ErrorCodeInvalidVideo       = "INVALID_VIDEO"
+	ErrorCodeNoVideoStream      = "NO_VIDEO_STREAM"
+	ErrorCodeDurationExceeded   = "DURATION_LIMIT_EXCEEDED"
+	ErrorCodeResolutionExceeded = "RESOLUTION_LIMIT_EXCEEDED"
+	ErrorCodeUnsupportedCodec   = "UNSUPPORTED_CODEC"
+)
+
 type Video struct {
-	ID           uuid.UUID   `gorm:"type:uuid;primaryKey"`
-	UserID       int64       `gorm:"not null"`
-	Filename     string      `gorm:"type:varchar(255);not null"`
-	OriginalPath string      `gorm:"type:text;not null"`
-	Status       VideoStatus `gorm:"type:varchar(20);not null"`
-	FPS          int         `gorm:"default:1"`
-	FrameCount   *int        `gorm:"type:int"`
-	ZipPath      *string     `gorm:"type:text"`
-	ErrorMessage *string     `gorm:"type:text"`
-	CreatedAt    time.Time   `gorm:"autoCreateTime"`
-	StartedAt    *time.Time  `gorm:"type:timestamp"`
-	CompletedAt  *time.Time  `gorm:"type:timestamp"`
-	ExpiresAt    time.Time   `gorm:"type:timestamp"`
+	ID                   uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	UserID               int64       `gorm:"not null"`
+	Filename             string      `gorm:"type:varchar(255);not null"`
+	OriginalPath         string      `gorm:"type:text;not null"`
+	Status               VideoStatus `gorm:"type:varchar(20);not null"`
+	FPS                  int         `gorm:"default:1"`
+	FrameRate            *float64    `gorm:"type:double precision"`
+	FrameCount           *int        `gorm:"type:int"`
+	DuplicateFrameCount  *int        `gorm:"type:int"`
+	LowQualityFrameCount *int        `gorm:"type:int"`
+	InactiveFrameCount   *int        `gorm:"type:int"`
+	ZipPath              *string     `gorm:"type:text"`
+	PreviewPath          *string     `gorm:"type:text"`
+	HLSPath              *string     `gorm:"type:text"`
+	HasAudio             *bool       `gorm:"type:boolean"`
+	AudioPath            *string     `gorm:"type:text"`
+	ActivityScores       []byte      `gorm:"type:bytea"`
+	ErrorMessage         *string     `gorm:"type:text"`
+	ErrorCode            *string     `gorm:"type:varchar(50)"`
+	FileSize             *int64      `gorm:"type:bigint"`
+	SourceDuration       *float64    `gorm:"type:double precision"`
+	OptionsProfile       *string     `gorm:"type:varchar(255)"`
+	Priority             int         `gorm:"type:smallint;not null;default:0"`
+	AwaitingUpload       bool        `gorm:"not null;default:false"`
+	MultipartUploadID    *string     `gorm:"type:text"`
+	CreatedAt            time.Time   `gorm:"autoCreateTime"`
+	StartedAt            *time.Time  `gorm:"type:timestamp"`
+	CompletedAt          *time.Time  `gorm:"type:timestamp"`
+	ExpiresAt            time.Time   `gorm:"type:timestamp"`
 }
 
 func (Video) TableName() string {
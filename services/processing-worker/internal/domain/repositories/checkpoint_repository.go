@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+)
+
+type CheckpointRepository interface {
+	FindByVideoID(ctx context.Context, videoID uuid.UUID) (*entities.Checkpoint, error)
+	SaveFrames(ctx context.Context, frames []*entities.CheckpointFrame) error
+	DeleteFrames(ctx context.Context, videoID uuid.UUID, frameIndexes []int) error
+	SaveStage(ctx context.Context, videoID uuid.UUID, stage string) error
+	DeleteByVideoID(ctx context.Context, videoID uuid.UUID) error
+}
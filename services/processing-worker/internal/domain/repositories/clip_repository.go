@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+)
+
+type ClipRepository interface {
+	MarkAsStarted(ctx context.Context, id uuid.UUID) error
+	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus, errorMsg *string) error
+	UpdateClipComplete(ctx context.Context, id uuid.UUID, mode entities.ClipMode, outputPath string, sizeBytes int64) error
+}
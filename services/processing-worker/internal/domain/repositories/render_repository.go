@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+)
+
+type RenderRepository interface {
+	MarkAsStarted(ctx context.Context, id uuid.UUID) error
+	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus, errorMsg *string) error
+	UpdateRenderComplete(ctx context.Context, id uuid.UUID, frameCount int, outputPath string) error
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+)
+
+type ShotRepository interface {
+	ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, shots []*entities.Shot) error
+}
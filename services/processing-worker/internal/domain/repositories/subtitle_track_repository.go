@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+)
+
+type SubtitleTrackRepository interface {
+	ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, tracks []*entities.SubtitleTrack) error
+}
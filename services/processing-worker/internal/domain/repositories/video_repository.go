@@ -11,5 +11,13 @@ type VideoRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*entities.Video, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus, errorMsg *string) error
 	UpdateProcessingComplete(ctx context.Context, id uuid.UUID, frameCount int, zipPath string) error
+	UpdatePreviewPath(ctx context.Context, id uuid.UUID, previewPath string) error
+	UpdateHLSPath(ctx context.Context, id uuid.UUID, hlsPath string) error
+	UpdateErrorCode(ctx context.Context, id uuid.UUID, errorCode string) error
+	UpdateFrameFilterStats(ctx context.Context, id uuid.UUID, stats entities.FrameFilterStats) error
+	UpdateAudioInfo(ctx context.Context, id uuid.UUID, hasAudio bool, audioPath *string) error
+	UpdateFrameRate(ctx context.Context, id uuid.UUID, frameRate float64) error
+	UpdateSourceDuration(ctx context.Context, id uuid.UUID, duration float64) error
+	UpdateActivityScores(ctx context.Context, id uuid.UUID, scores []byte) error
 	MarkAsStarted(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,15 @@
+//go:build linux || darwin
+
+package disk
+
+import "golang.org/x/sys/unix"
+
+// Available returns the bytes an unprivileged process can still write on
+// the filesystem holding path.
+func Available(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin
+
+package disk
+
+import "errors"
+
+// Available is not implemented on this platform.
+func Available(path string) (uint64, error) {
+	return 0, errors.ErrUnsupported
+}
@@ -0,0 +1,86 @@
+package fairness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/video-platform/shared/pkg/database/redis"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+// keyPrefix namespaces the per-user job slots in Redis.
+const keyPrefix = "user-jobs:"
+
+// missedBeats is how many refreshes a slot outlives, so the slot of a worker
+// that crashes is freed shortly after.
+const missedBeats = 3
+
+// Limiter caps how many jobs of one user run at once across all workers.
+// Each running job holds one of the user's slots, a Redis key that expires
+// unless the worker keeps refreshing it.
+type Limiter struct {
+	client   *redis.Client
+	limit    int
+	interval time.Duration
+}
+
+func NewLimiter(client *redis.Client, limit int, interval time.Duration) *Limiter {
+	return &Limiter{
+		client:   client,
+		limit:    limit,
+		interval: interval,
+	}
+}
+
+// Acquire takes a free slot of the user for the job and returns a func that
+// frees it. It reports false when all the user's slots are taken. A limit
+// of zero or less turns the limiter off.
+func (l *Limiter) Acquire(ctx context.Context, userID int64, jobID string) (func(), bool, error) {
+	if l.limit <= 0 {
+		return func() {}, true, nil
+	}
+
+	for slot := 0; slot < l.limit; slot++ {
+		key := fmt.Sprintf("%s%d:%d", keyPrefix, userID, slot)
+		ok, err := l.client.SetNX(ctx, key, jobID, missedBeats*l.interval)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to take job slot: %w", err)
+		}
+		if ok {
+			return l.hold(ctx, key, jobID), true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// hold refreshes the slot until the returned func is called, which frees
+// it unless it already expired and went to another job.
+func (l *Limiter) hold(ctx context.Context, key, jobID string) func() {
+	ctx = context.WithoutCancel(ctx)
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := l.client.Expire(ctx, key, missedBeats*l.interval); err != nil {
+					logging.Warn("Failed to refresh job slot", "key", key, "error", err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		if holder, err := l.client.Get(ctx, key); err == nil && holder == jobID {
+			if err := l.client.Del(ctx, key); err != nil {
+				logging.Warn("Failed to free job slot", "key", key, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package ffmpeg
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Keyframes lists the presentation times, in seconds, of the keyframes of
+// the first video stream.
+func (s *ffmpegService) Keyframes(ctx context.Context, videoPath string) ([]float64, error) {
+	cmd := exec.CommandContext(ctx, "ffprobe",
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "packet=pts_time,flags",
+		"-of", "csv=p=0",
+		videoPath,
+	)
+
+	output, err := s.output(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("ffprobe failed: %w", err)
+	}
+
+	return parseKeyframes(string(output)), nil
+}
+
+// parseKeyframes reads "pts_time,flags" packet lines and keeps those
+// flagged as keyframes. Packets without a timestamp are skipped.
+func parseKeyframes(output string) []float64 {
+	var keyframes []float64
+	for _, line := range strings.Split(output, "\n") {
+		pts, flags, found := strings.Cut(strings.TrimSpace(line), ",")
+		if !found || !strings.Contains(flags, "K") {
+			continue
+		}
+
+		t, err := strconv.ParseFloat(pts, 64)
+		if err != nil {
+			continue
+		}
+		keyframes = append(keyframes, t)
+	}
+
+	sort.Float64s(keyframes)
+	return keyframes
+}
+
+// CutClip writes the range [start, end) of the video to outputPath. With
+// streamCopy the streams are copied as is, which is only frame accurate when
+// start falls on a keyframe; otherwise the clip is re-encoded to H.264 and
+// AAC.
+func (s *ffmpegService) CutClip(ctx context.Context, videoPath, outputPath string, start, end float64, streamCopy bool) error {
+	args := []string{"-y",
+		"-ss", strconv.FormatFloat(start, 'f', 3, 64),
+		"-i", videoPath,
+		"-t", strconv.FormatFloat(end-start, 'f', 3, 64),
+		"-map", "0:v:0",
+		"-map", "0:a?",
+	}
+
+	if streamCopy {
+		args = append(args, "-c", "copy", "-avoid_negative_ts", "make_zero")
+	} else {
+		args = append(args,
+			"-c:v", "libx264",
+			"-preset", "veryfast",
+			"-crf", "20",
+			"-pix_fmt", "yuv420p",
+			"-c:a", "aac",
+			"-movflags", "+faststart",
+		)
+	}
+	args = append(args, outputPath)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	output, err := s.combinedOutput(cmd)
+	if err != nil {
+		return fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
+	}
+
+	return nil
+}
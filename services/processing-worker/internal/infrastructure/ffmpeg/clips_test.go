@@ -0,0 +1,38 @@
+package ffmpeg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKeyframes(t *testing.T) {
+	// ffprobe lists packets in decode order, so B-frames come out of
+	// presentation order; a trailing separator ends some lines.
+	output := "0.000000,K__\n" +
+		"0.125000,___\n" +
+		"0.041708,___\n" +
+		"4.004000,K__,\n" +
+		"2.002000,K_D\n" +
+		"6.006000,__D\n"
+
+	assert.Equal(t, []float64{0, 2.002, 4.004}, parseKeyframes(output))
+}
+
+func TestParseKeyframes_Empty(t *testing.T) {
+	assert.Empty(t, parseKeyframes(""))
+	assert.Empty(t, parseKeyframes("\n\n"))
+	assert.Empty(t, parseKeyframes("0.000000,___\n0.041708,___\n"))
+}
+
+func TestParseKeyframes_Malformed(t *testing.T) {
+	// Packets without a timestamp and lines that are not packets are
+	// skipped rather than failing the clip job.
+	output := "N/A,K__\n" +
+		"K__\n" +
+		"[mov,mp4 @ 0x1] stream 0, timescale not set\n" +
+		"1.500000,K__\r\n" +
+		",K__\n"
+
+	assert.Equal(t, []float64{1.5}, parseKeyframes(output))
+}
@@ -0,0 +1,61 @@
+package ffmpeg
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+)
+
+// EncodeOptions describes a video assembled from an image sequence. Format
+// is either "mp4" or "webm"; a zero Width or Height follows the aspect
+// ratio of the images, and both zero keeps their size.
+type EncodeOptions struct {
+	Format string
+	FPS    float64
+	Width  int
+	Height int
+}
+
+func (s *ffmpegService) EncodeImageSequence(ctx context.Context, inputPattern, outputPath string, opts EncodeOptions) error {
+	var codecArgs []string
+	switch opts.Format {
+	case "mp4":
+		codecArgs = []string{"-c:v", "libx264", "-preset", "veryfast", "-crf", "20", "-movflags", "+faststart"}
+	case "webm":
+		codecArgs = []string{"-c:v", "libvpx-vp9", "-crf", "32", "-b:v", "0"}
+	default:
+		return fmt.Errorf("unsupported render format: %s", opts.Format)
+	}
+
+	args := []string{"-y",
+		"-framerate", strconv.FormatFloat(opts.FPS, 'f', -1, 64),
+		"-i", inputPattern,
+		"-vf", encodeScale(opts.Width, opts.Height),
+		"-pix_fmt", "yuv420p",
+	}
+	args = append(args, codecArgs...)
+	args = append(args, outputPath)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	output, err := s.combinedOutput(cmd)
+	if err != nil {
+		return fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
+	}
+
+	return nil
+}
+
+// encodeScale keeps dimensions even, which yuv420p requires.
+func encodeScale(width, height int) string {
+	switch {
+	case width == 0 && height == 0:
+		return "scale=trunc(iw/2)*2:trunc(ih/2)*2"
+	case width == 0:
+		return fmt.Sprintf("scale=-2:%d", height)
+	case height == 0:
+		return fmt.Sprintf("scale=%d:-2", width)
+	default:
+		return fmt.Sprintf("scale=%d:%d", width, height)
+	}
+}
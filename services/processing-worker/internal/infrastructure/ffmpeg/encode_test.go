@@ -0,0 +1,26 @@
+package ffmpeg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeScale(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		want          string
+	}{
+		{"source size rounded down to even", 0, 0, "scale=trunc(iw/2)*2:trunc(ih/2)*2"},
+		{"height only", 0, 720, "scale=-2:720"},
+		{"width only", 1280, 0, "scale=1280:-2"},
+		{"both", 640, 360, "scale=640:360"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, encodeScale(tt.width, tt.height))
+		})
+	}
+}
@@ -7,29 +7,96 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
+)
+
+const (
+	previewSegments = 4
+	gifPreviewFPS   = 10
+	mp4PreviewFPS   = 24
 )
 
 type FFmpegService interface {
-	ExtractFrames(ctx context.Context, videoPath, outputDir string, fps int) (int, error)
+	ExtractFrames(ctx context.Context, videoPath, outputDir string, opts ExtractOptions) (int, error)
+	GeneratePreview(ctx context.Context, videoPath, outputPath string, opts PreviewOptions) error
+	ExtractAudio(ctx context.Context, videoPath, outputPath, format string) error
+	ExtractSubtitle(ctx context.Context, videoPath, outputPath string, streamIndex int, format string) error
+	Probe(ctx context.Context, videoPath string) (*ProbeResult, error)
+	DetectScenes(ctx context.Context, videoPath string, threshold float64) ([]float64, error)
+	EncodeImageSequence(ctx context.Context, inputPattern, outputPath string, opts EncodeOptions) error
+	Keyframes(ctx context.Context, videoPath string) ([]float64, error)
+	CutClip(ctx context.Context, videoPath, outputPath string, start, end float64, streamCopy bool) error
+	TranscodeHLS(ctx context.Context, videoPath, outputDir string, rendition HLSRendition) error
+}
+
+// PreviewOptions describes a short looping preview. Format is either "gif"
+// or "mp4"; Duration is the length of the preview in seconds.
+type PreviewOptions struct {
+	Format   string
+	Duration float64
+	Width    int
 }
 
-type ffmpegService struct{}
+type ffmpegService struct {
+	limits ProcessLimits
+}
+
+func NewFFmpegService(limits ProcessLimits) FFmpegService {
+	return &ffmpegService{
+		limits: limits,
+	}
+}
 
-func NewFFmpegService() FFmpegService {
-	return &ffmpegService{}
+// ExtractOptions controls frame extraction. Video, when set, selects the
+// stream and applies its corrections; Overlay is drawn on every extracted
+// frame after them. A non-zero Duration extracts only the segment starting
+// at Start, in seconds, and numbers its frames from StartNumber.
+type ExtractOptions struct {
+	FPS         int
+	Video       *VideoPipeline
+	Overlay     *Overlay
+	Start       float64
+	Duration    float64
+	StartNumber int
 }
 
-func (s *ffmpegService) ExtractFrames(ctx context.Context, videoPath, outputDir string, fps int) (int, error) {
+func (s *ffmpegService) ExtractFrames(ctx context.Context, videoPath, outputDir string, opts ExtractOptions) (int, error) {
 	outputPattern := filepath.Join(outputDir, "frame_%04d.jpg")
 
-	cmd := exec.CommandContext(ctx, "ffmpeg",
-		"-i", videoPath,
-		"-vf", fmt.Sprintf("fps=%d", fps),
-		"-qscale:v", "2",
-		outputPattern,
-	)
+	var args []string
+	sampling := fmt.Sprintf("fps=%d", opts.FPS)
+	graph := "[0:v]" + sampling
 
-	output, err := cmd.CombinedOutput()
+	if opts.Video != nil {
+		args = append(args, "-noautorotate")
+		graph = opts.Video.inputLabel() + opts.Video.chain(sampling)
+	}
+
+	firstFrame := 1
+	if opts.Duration > 0 {
+		args = append(args,
+			"-ss", strconv.FormatFloat(opts.Start, 'f', 3, 64),
+			"-t", strconv.FormatFloat(opts.Duration, 'f', 3, 64),
+		)
+		firstFrame = opts.StartNumber
+	}
+	args = append(args, "-i", videoPath)
+
+	if opts.Overlay != nil {
+		overlayArgs, overlayFilter, cleanup, err := overlayGraph(graph, opts.Overlay, opts.Start, firstFrame)
+		if err != nil {
+			return 0, err
+		}
+		defer cleanup()
+
+		args = append(args, overlayArgs...)
+		graph = overlayFilter
+	}
+
+	args = append(args, "-filter_complex", graph, "-qscale:v", "2", "-start_number", strconv.Itoa(firstFrame), outputPattern)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	output, err := s.combinedOutput(cmd)
 	if err != nil {
 		return 0, fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
 	}
@@ -49,7 +116,110 @@ func (s *ffmpegService) ExtractFrames(ctx context.Context, videoPath, outputDir
 	return frameCount, nil
 }
 
-func GetVideoDuration(ctx context.Context, videoPath string) (float64, error) {
+func (s *ffmpegService) GeneratePreview(ctx context.Context, videoPath, outputPath string, opts PreviewOptions) error {
+	sourceDuration, err := s.videoDuration(ctx, videoPath)
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	switch opts.Format {
+	case "gif":
+		filter := fmt.Sprintf("%s,scale=%d:-1:flags=lanczos,split[a][b];[a]palettegen=stats_mode=diff[p];[b][p]paletteuse=dither=bayer:bayer_scale=5",
+			previewSampling(sourceDuration, opts.Duration, gifPreviewFPS), opts.Width)
+		args = []string{"-y", "-i", videoPath, "-vf", filter, "-loop", "0", outputPath}
+	case "mp4":
+		filter := fmt.Sprintf("%s,scale=%d:-2", previewSampling(sourceDuration, opts.Duration, mp4PreviewFPS), opts.Width)
+		args = []string{"-y", "-i", videoPath, "-vf", filter,
+			"-an",
+			"-c:v", "libx264",
+			"-preset", "veryfast",
+			"-crf", "32",
+			"-maxrate", "400k",
+			"-bufsize", "800k",
+			"-pix_fmt", "yuv420p",
+			"-movflags", "+faststart",
+			outputPath,
+		}
+	default:
+		return fmt.Errorf("unsupported preview format: %s", opts.Format)
+	}
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	output, err := s.combinedOutput(cmd)
+	if err != nil {
+		return fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
+	}
+
+	return nil
+}
+
+func (s *ffmpegService) ExtractAudio(ctx context.Context, videoPath, outputPath, format string) error {
+	var codecArgs []string
+	switch format {
+	case "wav":
+		codecArgs = []string{"-c:a", "pcm_s16le"}
+	case "flac":
+		codecArgs = []string{"-c:a", "flac"}
+	case "mp3":
+		codecArgs = []string{"-c:a", "libmp3lame", "-q:a", "2"}
+	default:
+		return fmt.Errorf("unsupported audio format: %s", format)
+	}
+
+	args := append([]string{"-y", "-i", videoPath, "-vn", "-map", "0:a:0"}, codecArgs...)
+	args = append(args, outputPath)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	output, err := s.combinedOutput(cmd)
+	if err != nil {
+		return fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
+	}
+
+	return nil
+}
+
+func (s *ffmpegService) ExtractSubtitle(ctx context.Context, videoPath, outputPath string, streamIndex int, format string) error {
+	var codec string
+	switch format {
+	case "srt":
+		codec = "srt"
+	case "vtt":
+		codec = "webvtt"
+	default:
+		return fmt.Errorf("unsupported subtitle format: %s", format)
+	}
+
+	cmd := exec.CommandContext(ctx, "ffmpeg",
+		"-y",
+		"-i", videoPath,
+		"-map", fmt.Sprintf("0:%d", streamIndex),
+		"-c:s", codec,
+		outputPath,
+	)
+
+	output, err := s.combinedOutput(cmd)
+	if err != nil {
+		return fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
+	}
+
+	return nil
+}
+
+// previewSampling builds the filter chain that picks evenly spaced clips
+// across the source and retimes them into one continuous sequence. Sources
+// shorter than the preview are used whole.
+func previewSampling(sourceDuration, previewDuration float64, fps int) string {
+	if sourceDuration <= previewDuration {
+		return fmt.Sprintf("fps=%d", fps)
+	}
+
+	interval := sourceDuration / previewSegments
+	clip := previewDuration / previewSegments
+	return fmt.Sprintf("fps=%d,select='lt(mod(t,%.3f),%.3f)',setpts=N/(%d*TB)", fps, interval, clip, fps)
+}
+
+func (s *ffmpegService) videoDuration(ctx context.Context, videoPath string) (float64, error) {
 	cmd := exec.CommandContext(ctx, "ffprobe",
 		"-v", "error",
 		"-show_entries", "format=duration",
@@ -57,12 +227,12 @@ func GetVideoDuration(ctx context.Context, videoPath string) (float64, error) {
 		videoPath,
 	)
 
-	output, err := cmd.Output()
+	output, err := s.output(cmd)
 	if err != nil {
 		return 0, fmt.Errorf("ffprobe failed: %w", err)
 	}
 
-	duration, err := strconv.ParseFloat(string(output), 64)
+	duration, err := strconv.ParseFloat(strings.TrimSpace(string(output)), 64)
 	if err != nil {
 		return 0, fmt.Errorf("failed to parse duration: %w", err)
 	}
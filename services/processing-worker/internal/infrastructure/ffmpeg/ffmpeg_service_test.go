@@ -0,0 +1,52 @@
+package ffmpeg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewSampling(t *testing.T) {
+	tests := []struct {
+		name            string
+		sourceDuration  float64
+		previewDuration float64
+		fps             int
+		want            string
+	}{
+		{
+			name:            "source shorter than the preview is used whole",
+			sourceDuration:  2.5,
+			previewDuration: 4,
+			fps:             10,
+			want:            "fps=10",
+		},
+		{
+			name:            "source as long as the preview is used whole",
+			sourceDuration:  4,
+			previewDuration: 4,
+			fps:             10,
+			want:            "fps=10",
+		},
+		{
+			name:            "one second from every 30",
+			sourceDuration:  120,
+			previewDuration: 4,
+			fps:             10,
+			want:            "fps=10,select='lt(mod(t,30.000),1.000)',setpts=N/(10*TB)",
+		},
+		{
+			name:            "fractional clips",
+			sourceDuration:  10.5,
+			previewDuration: 3,
+			fps:             15,
+			want:            "fps=15,select='lt(mod(t,2.625),0.750)',setpts=N/(15*TB)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, previewSampling(tt.sourceDuration, tt.previewDuration, tt.fps))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package ffmpeg
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const hlsSegmentSeconds = 6
+
+// HLSRendition is one rung of a streaming ladder. Bitrates are in kbit/s; a
+// zero AudioBitrate drops the audio track. Video, when set, selects the
+// stream and applies its corrections before scaling; Width and Height are
+// the displayed size.
+type HLSRendition struct {
+	Width        int
+	Height       int
+	VideoBitrate int
+	AudioBitrate int
+	Video        *VideoPipeline
+}
+
+// TranscodeHLS encodes one rendition as a VOD media playlist, index.m3u8,
+// with its MPEG-TS segments in outputDir. Keyframes are forced on segment
+// boundaries so every rendition switches cleanly.
+func (s *ffmpegService) TranscodeHLS(ctx context.Context, videoPath, outputDir string, rendition HLSRendition) error {
+	// Scaling to the height alone keeps the aspect ratio of the corrected
+	// frames, rotated ones included.
+	videoMap := "0:v:0"
+	filters := []string{fmt.Sprintf("scale=-2:%d", rendition.Height)}
+	args := []string{"-y"}
+	if rendition.Video != nil {
+		args = append(args, "-noautorotate")
+		videoMap = fmt.Sprintf("0:%d", rendition.Video.StreamIndex)
+		if chain := rendition.Video.chain(""); chain != "" {
+			filters = append([]string{chain}, filters...)
+		}
+	}
+
+	args = append(args, "-i", videoPath,
+		"-map", videoMap,
+		"-vf", strings.Join(filters, ","),
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-profile:v", "main",
+		"-pix_fmt", "yuv420p",
+		"-b:v", fmt.Sprintf("%dk", rendition.VideoBitrate),
+		"-maxrate", fmt.Sprintf("%dk", rendition.VideoBitrate),
+		"-bufsize", fmt.Sprintf("%dk", 2*rendition.VideoBitrate),
+		"-force_key_frames", fmt.Sprintf("expr:gte(t,n_forced*%d)", hlsSegmentSeconds),
+		"-sc_threshold", "0",
+	)
+
+	if rendition.AudioBitrate > 0 {
+		args = append(args,
+			"-map", "0:a:0",
+			"-c:a", "aac",
+			"-b:a", fmt.Sprintf("%dk", rendition.AudioBitrate),
+			"-ac", "2",
+		)
+	}
+
+	args = append(args,
+		"-f", "hls",
+		"-hls_time", fmt.Sprint(hlsSegmentSeconds),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", filepath.Join(outputDir, "segment_%04d.ts"),
+		filepath.Join(outputDir, "index.m3u8"),
+	)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	output, err := s.combinedOutput(cmd)
+	if err != nil {
+		return fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"os/exec"
+	"time"
+
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+// ProcessLimits are applied to every ffmpeg and ffprobe child so a single
+// source cannot starve the node. A zero field leaves that setting alone.
+type ProcessLimits struct {
+	// Nice is the child's niceness, from -20 to 19.
+	Nice int
+	// MaxMemory caps the child's address space, in bytes.
+	MaxMemory uint64
+	// MaxCPUTime caps the CPU time the child may use across all threads.
+	MaxCPUTime time.Duration
+}
+
+// run starts cmd, applies the limits to the child and waits for it. The
+// limits land just after the child starts, so its first moments run
+// unrestricted.
+func (s *ffmpegService) run(cmd *exec.Cmd) error {
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	if err := applyLimits(cmd.Process.Pid, s.limits); err != nil {
+		logging.Warn("Failed to apply process limits", "pid", cmd.Process.Pid, "error", err)
+	}
+
+	return cmd.Wait()
+}
+
+func (s *ffmpegService) combinedOutput(cmd *exec.Cmd) ([]byte, error) {
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	err := s.run(cmd)
+	return output.Bytes(), err
+}
+
+func (s *ffmpegService) output(cmd *exec.Cmd) ([]byte, error) {
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	err := s.run(cmd)
+	return output.Bytes(), err
+}
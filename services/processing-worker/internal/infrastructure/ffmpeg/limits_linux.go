@@ -0,0 +1,34 @@
+//go:build linux
+
+package ffmpeg
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+func applyLimits(pid int, limits ProcessLimits) error {
+	if limits.Nice != 0 {
+		if err := unix.Setpriority(unix.PRIO_PROCESS, pid, limits.Nice); err != nil {
+			return fmt.Errorf("failed to set niceness: %w", err)
+		}
+	}
+
+	if limits.MaxMemory > 0 {
+		rlimit := unix.Rlimit{Cur: limits.MaxMemory, Max: limits.MaxMemory}
+		if err := unix.Prlimit(pid, unix.RLIMIT_AS, &rlimit, nil); err != nil {
+			return fmt.Errorf("failed to limit memory: %w", err)
+		}
+	}
+
+	if limits.MaxCPUTime > 0 {
+		seconds := uint64(limits.MaxCPUTime.Seconds())
+		rlimit := unix.Rlimit{Cur: seconds, Max: seconds}
+		if err := unix.Prlimit(pid, unix.RLIMIT_CPU, &rlimit, nil); err != nil {
+			return fmt.Errorf("failed to limit CPU time: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+
+package ffmpeg
+
+// applyLimits is a no-op off Linux; the worker image only runs there.
+func applyLimits(pid int, limits ProcessLimits) error {
+	return nil
+}
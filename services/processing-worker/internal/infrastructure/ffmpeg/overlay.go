@@ -0,0 +1,103 @@
+package ffmpeg
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const overlayMargin = 10
+
+// Overlay is a watermark drawn on extracted frames: an image, a text, or
+// both at the same position. Text may use {filename}, {timestamp} and
+// {frame}, which expand to Filename, the frame's position in the source and
+// its 1-based index. Opacity ranges from 0 to 1.
+type Overlay struct {
+	ImagePath string
+	Text      string
+	Filename  string
+	Position  string
+	Opacity   float64
+	FontSize  int
+}
+
+// overlayGraph extends the labeled base filter chain with the overlay and
+// returns the extra inputs, the filter graph, and a cleanup for the
+// temporary text file drawtext reads from. start and firstFrame place a
+// segment's frames in the whole source for the text placeholders.
+func overlayGraph(base string, overlay *Overlay, start float64, firstFrame int) ([]string, string, func(), error) {
+	var args []string
+	cleanup := func() {}
+	graph := base
+
+	if overlay.ImagePath != "" {
+		x, y := overlayPosition(overlay.Position, "W", "H", "w", "h")
+		args = append(args, "-i", overlay.ImagePath)
+		graph += fmt.Sprintf("[base];[1:v]format=rgba,colorchannelmixer=aa=%.2f[mark];[base][mark]overlay=%s:%s",
+			overlay.Opacity, x, y)
+	}
+
+	if overlay.Text != "" {
+		// The text goes through a file so that it needs no filter graph
+		// escaping, only drawtext's own expansion escaping.
+		file, err := os.CreateTemp("", "overlay-*.txt")
+		if err != nil {
+			return nil, "", cleanup, fmt.Errorf("failed to create overlay text file: %w", err)
+		}
+		cleanup = func() { os.Remove(file.Name()) }
+
+		_, err = file.WriteString(drawtextTemplate(overlay.Text, overlay.Filename, start, firstFrame))
+		file.Close()
+		if err != nil {
+			cleanup()
+			return nil, "", func() {}, fmt.Errorf("failed to write overlay text file: %w", err)
+		}
+
+		x, y := overlayPosition(overlay.Position, "w", "h", "tw", "th")
+		graph += fmt.Sprintf(",drawtext=textfile='%s':fontsize=%d:fontcolor=white@%.2f:shadowcolor=black@%.2f:shadowx=1:shadowy=1:x=%s:y=%s",
+			escapeFilterValue(file.Name()), overlay.FontSize, overlay.Opacity, overlay.Opacity, x, y)
+	}
+
+	return args, graph, cleanup, nil
+}
+
+// overlayPosition returns the x and y expressions placing an item of size
+// itemW x itemH in a frame of size frameW x frameH.
+func overlayPosition(position, frameW, frameH, itemW, itemH string) (string, string) {
+	left := fmt.Sprint(overlayMargin)
+	top := fmt.Sprint(overlayMargin)
+	right := fmt.Sprintf("%s-%s-%d", frameW, itemW, overlayMargin)
+	bottom := fmt.Sprintf("%s-%s-%d", frameH, itemH, overlayMargin)
+
+	switch position {
+	case "top-left":
+		return left, top
+	case "top-right":
+		return right, top
+	case "bottom-left":
+		return left, bottom
+	case "center":
+		return fmt.Sprintf("(%s-%s)/2", frameW, itemW), fmt.Sprintf("(%s-%s)/2", frameH, itemH)
+	default:
+		return right, bottom
+	}
+}
+
+// drawtextTemplate escapes the literal text for drawtext's expansion and
+// turns the placeholders into expansion functions, offset by where the
+// extracted segment starts. The filename is escaped too and substituted in
+// the same pass, so placeholders or expansions in it stay literal.
+func drawtextTemplate(text, filename string, start float64, firstFrame int) string {
+	escape := strings.NewReplacer(`\`, `\\`, `%`, `\%`)
+	return strings.NewReplacer(
+		"{filename}", escape.Replace(filename),
+		"{timestamp}", fmt.Sprintf("%%{pts:hms:%.3f}", start),
+		"{frame}", fmt.Sprintf("%%{eif:n+%d:d}", firstFrame),
+	).Replace(escape.Replace(text))
+}
+
+// escapeFilterValue escapes a value quoted with single quotes inside a
+// filter graph.
+func escapeFilterValue(value string) string {
+	return strings.ReplaceAll(value, `'`, `'\''`)
+}
@@ -0,0 +1,91 @@
+package ffmpeg
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawtextTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		filename string
+		want     string
+	}{
+		{
+			name: "plain text",
+			text: "Confidential",
+			want: "Confidential",
+		},
+		{
+			name: "placeholders offset by the segment",
+			text: "{timestamp} #{frame}",
+			want: "%{pts:hms:300.000} #%{eif:n+151:d}",
+		},
+		{
+			name: "literal percent and backslash",
+			text: `100% C:\clips`,
+			want: `100\% C:\\clips`,
+		},
+		{
+			name:     "filename",
+			text:     "{filename} #{frame}",
+			filename: "holiday.mp4",
+			want:     "holiday.mp4 #%{eif:n+151:d}",
+		},
+		{
+			name:     "filename with expansions, quotes and colons",
+			text:     "{filename}",
+			filename: `it's 50%{pts}: {frame} \ {timestamp}.mov`,
+			want:     `it's 50\%{pts}: {frame} \\ {timestamp}.mov`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, drawtextTemplate(tt.text, tt.filename, 300, 151))
+		})
+	}
+}
+
+func TestOverlayGraph_Text(t *testing.T) {
+	overlay := &Overlay{
+		Text:     "{filename} {timestamp}",
+		Filename: "a:b'%{n}.mp4",
+		Position: "top-left",
+		Opacity:  0.5,
+		FontSize: 24,
+	}
+
+	args, graph, cleanup, err := overlayGraph("[0:0]fps=1", overlay, 0, 1)
+	defer cleanup()
+
+	assert.NoError(t, err)
+	assert.Empty(t, args)
+
+	match := regexp.MustCompile(`^\[0:0\]fps=1,drawtext=textfile='([^']+)':fontsize=24:fontcolor=white@0\.50:shadowcolor=black@0\.50:shadowx=1:shadowy=1:x=10:y=10$`).FindStringSubmatch(graph)
+	if assert.Len(t, match, 2) {
+		// The text lives in the file, out of reach of filter graph parsing.
+		content, err := os.ReadFile(match[1])
+		assert.NoError(t, err)
+		assert.Equal(t, `a:b'\%{n}.mp4 %{pts:hms:0.000}`, string(content))
+
+		cleanup()
+		_, err = os.Stat(match[1])
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestOverlayGraph_Image(t *testing.T) {
+	overlay := &Overlay{ImagePath: "/tmp/mark.png", Opacity: 0.8}
+
+	args, graph, cleanup, err := overlayGraph("[0:0]fps=1", overlay, 0, 1)
+	defer cleanup()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"-i", "/tmp/mark.png"}, args)
+	assert.Equal(t, "[0:0]fps=1[base];[1:v]format=rgba,colorchannelmixer=aa=0.80[mark];[base][mark]overlay=W-w-10:H-h-10", graph)
+}
@@ -0,0 +1,216 @@
+package ffmpeg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// ProbeResult is the subset of ffprobe output the worker relies on.
+type ProbeResult struct {
+	Duration  float64
+	StartTime float64
+	Streams   []Stream
+}
+
+type Stream struct {
+	Index     int
+	CodecType string
+	CodecName string
+	Width     int
+	Height    int
+	FrameRate float64
+	Language  string
+	// Rotation is the clockwise rotation, in degrees, the container asks
+	// players to apply.
+	Rotation      int
+	FieldOrder    string
+	ColorTransfer string
+}
+
+func (p *ProbeResult) HasAudio() bool {
+	return len(p.StreamsOfType("audio")) > 0
+}
+
+// VideoStream returns the first video stream, or nil when there is none.
+func (p *ProbeResult) VideoStream() *Stream {
+	for i := range p.Streams {
+		if p.Streams[i].CodecType == "video" {
+			return &p.Streams[i]
+		}
+	}
+	return nil
+}
+
+// Stream returns the stream with the given index, or nil when there is none.
+func (p *ProbeResult) Stream(index int) *Stream {
+	for i := range p.Streams {
+		if p.Streams[i].Index == index {
+			return &p.Streams[i]
+		}
+	}
+	return nil
+}
+
+func (p *ProbeResult) StreamsOfType(codecType string) []Stream {
+	var streams []Stream
+	for _, stream := range p.Streams {
+		if stream.CodecType == codecType {
+			streams = append(streams, stream)
+		}
+	}
+	return streams
+}
+
+// textSubtitleCodecs are the subtitle codecs that can be converted to SRT and
+// WebVTT. Bitmap formats (PGS, DVB, VobSub) would need OCR.
+var textSubtitleCodecs = map[string]bool{
+	"subrip":   true,
+	"srt":      true,
+	"ass":      true,
+	"ssa":      true,
+	"webvtt":   true,
+	"mov_text": true,
+	"text":     true,
+}
+
+func (s Stream) IsTextSubtitle() bool {
+	return s.CodecType == "subtitle" && textSubtitleCodecs[s.CodecName]
+}
+
+type probeSideData struct {
+	Rotation *float64 `json:"rotation"`
+}
+
+type probeOutput struct {
+	Format struct {
+		Duration  string `json:"duration"`
+		StartTime string `json:"start_time"`
+	} `json:"format"`
+	Streams []struct {
+		Index         int               `json:"index"`
+		CodecType     string            `json:"codec_type"`
+		CodecName     string            `json:"codec_name"`
+		Width         int               `json:"width"`
+		Height        int               `json:"height"`
+		AvgFrameRate  string            `json:"avg_frame_rate"`
+		RFrameRate    string            `json:"r_frame_rate"`
+		FieldOrder    string            `json:"field_order"`
+		ColorTransfer string            `json:"color_transfer"`
+		Tags          map[string]string `json:"tags"`
+		SideDataList  []probeSideData   `json:"side_data_list"`
+	} `json:"streams"`
+}
+
+func (s *ffmpegService) Probe(ctx context.Context, videoPath string) (*ProbeResult, error) {
+	cmd := exec.CommandContext(ctx, "ffprobe",
+		"-v", "error",
+		"-print_format", "json",
+		"-show_format",
+		"-show_streams",
+		videoPath,
+	)
+
+	output, err := s.output(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("ffprobe failed: %w", err)
+	}
+
+	return parseProbeOutput(output)
+}
+
+func parseProbeOutput(data []byte) (*ProbeResult, error) {
+	var out probeOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse ffprobe output: %w", err)
+	}
+
+	result := &ProbeResult{}
+	if out.Format.Duration != "" {
+		duration, err := strconv.ParseFloat(out.Format.Duration, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse duration: %w", err)
+		}
+		result.Duration = duration
+	}
+
+	if out.Format.StartTime != "" {
+		startTime, err := strconv.ParseFloat(out.Format.StartTime, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse start time: %w", err)
+		}
+		result.StartTime = startTime
+	}
+
+	for _, stream := range out.Streams {
+		frameRate := parseRational(stream.AvgFrameRate)
+		if frameRate == 0 {
+			frameRate = parseRational(stream.RFrameRate)
+		}
+
+		result.Streams = append(result.Streams, Stream{
+			Index:     stream.Index,
+			CodecType: stream.CodecType,
+			CodecName: stream.CodecName,
+			Width:     stream.Width,
+			Height:    stream.Height,
+			FrameRate: frameRate,
+			Language:  stream.Tags["language"],
+
+			Rotation:      streamRotation(stream.Tags["rotate"], stream.SideDataList),
+			FieldOrder:    stream.FieldOrder,
+			ColorTransfer: stream.ColorTransfer,
+		})
+	}
+
+	return result, nil
+}
+
+// streamRotation reads the display matrix, whose rotation is
+// counter-clockwise, falling back to the legacy clockwise rotate tag.
+func streamRotation(rotateTag string, sideData []probeSideData) int {
+	for _, data := range sideData {
+		if data.Rotation != nil {
+			return normalizeRotation(-int(math.Round(*data.Rotation)))
+		}
+	}
+
+	rotate, err := strconv.Atoi(rotateTag)
+	if err != nil {
+		return 0
+	}
+	return normalizeRotation(rotate)
+}
+
+// normalizeRotation maps degrees onto 0, 90, 180 or 270.
+func normalizeRotation(degrees int) int {
+	degrees %= 360
+	if degrees < 0 {
+		degrees += 360
+	}
+	return (degrees + 45) / 90 * 90 % 360
+}
+
+// parseRational parses ffprobe rates such as "30000/1001". Unknown rates
+// ("0/0") and malformed values yield 0.
+func parseRational(value string) float64 {
+	num, den, found := strings.Cut(value, "/")
+	if !found {
+		f, _ := strconv.ParseFloat(value, 64)
+		return f
+	}
+
+	n, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0
+	}
+	d, err := strconv.ParseFloat(den, 64)
+	if err != nil || d == 0 {
+		return 0
+	}
+	return n / d
+}
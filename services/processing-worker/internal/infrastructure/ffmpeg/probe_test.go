@@ -0,0 +1,290 @@
+package ffmpeg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// phoneProbe is a portrait phone recording: the frames are stored
+// landscape and a display matrix rotates them -90 degrees, i.e. 90
+// clockwise.
+const phoneProbe = `{
+	"streams": [
+		{
+			"index": 0,
+			"codec_name": "h264",
+			"codec_type": "video",
+			"width": 1920,
+			"height": 1080,
+			"r_frame_rate": "30/1",
+			"avg_frame_rate": "30000/1001",
+			"field_order": "progressive",
+			"color_transfer": "bt709",
+			"tags": {"language": "und", "handler_name": "Core Media Video"},
+			"side_data_list": [
+				{
+					"side_data_type": "Display Matrix",
+					"displaymatrix": "\n00000000:            0       65536           0\n00000001:       -65536           0           0\n00000002:            0           0  1073741824\n",
+					"rotation": -90
+				}
+			]
+		},
+		{
+			"index": 1,
+			"codec_name": "aac",
+			"codec_type": "audio",
+			"r_frame_rate": "0/0",
+			"avg_frame_rate": "0/0",
+			"tags": {"language": "eng"}
+		}
+	],
+	"format": {
+		"filename": "phone.mov",
+		"start_time": "0.021333",
+		"duration": "12.345000"
+	}
+}`
+
+// legacyRotateProbe carries only the rotate tag older muxers wrote.
+const legacyRotateProbe = `{
+	"streams": [
+		{
+			"index": 0,
+			"codec_name": "h264",
+			"codec_type": "video",
+			"width": 1280,
+			"height": 720,
+			"r_frame_rate": "25/1",
+			"avg_frame_rate": "0/0",
+			"tags": {"rotate": "270"}
+		}
+	],
+	"format": {"duration": "4.000000"}
+}`
+
+// multiVideoProbe has cover art, which ffprobe reports as a video stream,
+// ahead of an interlaced main programme and an HDR alternate angle.
+const multiVideoProbe = `{
+	"streams": [
+		{
+			"index": 0,
+			"codec_name": "mjpeg",
+			"codec_type": "video",
+			"width": 600,
+			"height": 600,
+			"r_frame_rate": "90000/1",
+			"avg_frame_rate": "0/0"
+		},
+		{
+			"index": 1,
+			"codec_name": "mpeg2video",
+			"codec_type": "video",
+			"width": 720,
+			"height": 576,
+			"r_frame_rate": "25/1",
+			"avg_frame_rate": "25/1",
+			"field_order": "tt"
+		},
+		{
+			"index": 2,
+			"codec_name": "hevc",
+			"codec_type": "video",
+			"width": 3840,
+			"height": 2160,
+			"r_frame_rate": "24000/1001",
+			"avg_frame_rate": "24000/1001",
+			"color_transfer": "smpte2084",
+			"side_data_list": [
+				{"side_data_type": "Mastering display metadata"},
+				{"side_data_type": "Display Matrix", "rotation": 180}
+			]
+		},
+		{
+			"index": 3,
+			"codec_name": "ac3",
+			"codec_type": "audio",
+			"r_frame_rate": "0/0",
+			"avg_frame_rate": "0/0"
+		}
+	],
+	"format": {"start_time": "1.400000", "duration": "60.000000"}
+}`
+
+func TestParseProbeOutput_DisplayMatrix(t *testing.T) {
+	probe, err := parseProbeOutput([]byte(phoneProbe))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12.345, probe.Duration)
+	assert.Equal(t, 0.021333, probe.StartTime)
+	assert.True(t, probe.HasAudio())
+	assert.Len(t, probe.Streams, 2)
+
+	video := probe.VideoStream()
+	assert.NotNil(t, video)
+	assert.Equal(t, 90, video.Rotation)
+	assert.Equal(t, 1920, video.Width)
+	assert.Equal(t, 1080, video.Height)
+	assert.InDelta(t, 29.97, video.FrameRate, 0.001)
+	assert.False(t, video.IsInterlaced())
+	assert.False(t, video.IsHDR())
+
+	audio := probe.Stream(1)
+	assert.NotNil(t, audio)
+	assert.Equal(t, "eng", audio.Language)
+	assert.Equal(t, 0, audio.Rotation)
+	assert.Equal(t, 0.0, audio.FrameRate)
+}
+
+func TestParseProbeOutput_LegacyRotateTag(t *testing.T) {
+	probe, err := parseProbeOutput([]byte(legacyRotateProbe))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, probe.StartTime)
+	assert.False(t, probe.HasAudio())
+
+	video := probe.VideoStream()
+	assert.NotNil(t, video)
+	assert.Equal(t, 270, video.Rotation)
+	// avg_frame_rate is unknown, so the real base rate is used.
+	assert.Equal(t, 25.0, video.FrameRate)
+}
+
+func TestParseProbeOutput_Malformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"not json", `ffprobe: error`},
+		{"bad duration", `{"format": {"duration": "abc"}}`},
+		{"bad start time", `{"format": {"duration": "1.0", "start_time": "abc"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, err := parseProbeOutput([]byte(tt.data))
+
+			assert.Error(t, err)
+			assert.Nil(t, probe)
+		})
+	}
+}
+
+func TestResolveVideoPipeline_StreamSelection(t *testing.T) {
+	probe, err := parseProbeOutput([]byte(multiVideoProbe))
+	assert.NoError(t, err)
+
+	index := func(i int) *int { return &i }
+
+	tests := []struct {
+		name     string
+		settings VideoSettings
+		want     *VideoPipeline
+		wantErr  bool
+	}{
+		{
+			name:     "first video stream by default",
+			settings: VideoSettings{},
+			want:     &VideoPipeline{StreamIndex: 0},
+		},
+		{
+			name:     "interlaced stream is deinterlaced",
+			settings: VideoSettings{StreamIndex: index(1)},
+			want:     &VideoPipeline{StreamIndex: 1, Deinterlace: DeinterlaceYadif},
+		},
+		{
+			name:     "rotated HDR stream",
+			settings: VideoSettings{StreamIndex: index(2)},
+			want:     &VideoPipeline{StreamIndex: 2, Rotation: 180, ToneMap: true},
+		},
+		{
+			name:     "rotation override is normalized",
+			settings: VideoSettings{StreamIndex: index(2), Rotation: index(-90), ToneMap: ToneMapOff},
+			want:     &VideoPipeline{StreamIndex: 2, Rotation: 270},
+		},
+		{
+			name:     "audio stream",
+			settings: VideoSettings{StreamIndex: index(3)},
+			wantErr:  true,
+		},
+		{
+			name:     "missing stream",
+			settings: VideoSettings{StreamIndex: index(7)},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline, err := ResolveVideoPipeline(probe, tt.settings)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, pipeline)
+		})
+	}
+}
+
+func TestStreamRotation(t *testing.T) {
+	rotation := func(degrees float64) probeSideData { return probeSideData{Rotation: &degrees} }
+
+	tests := []struct {
+		name      string
+		rotateTag string
+		sideData  []probeSideData
+		want      int
+	}{
+		{"no metadata", "", nil, 0},
+		{"counter-clockwise matrix", "", []probeSideData{rotation(-90)}, 90},
+		{"clockwise matrix", "", []probeSideData{rotation(90)}, 270},
+		{"upside down", "", []probeSideData{rotation(180)}, 180},
+		{"inexact matrix angle", "", []probeSideData{rotation(-89.98)}, 90},
+		{"matrix wins over tag", "180", []probeSideData{rotation(-90)}, 90},
+		{"side data without rotation", "90", []probeSideData{{}}, 90},
+		{"legacy tag", "90", nil, 90},
+		{"negative legacy tag", "-90", nil, 270},
+		{"malformed tag", "ninety", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, streamRotation(tt.rotateTag, tt.sideData))
+		})
+	}
+}
+
+func TestNormalizeRotation(t *testing.T) {
+	tests := []struct {
+		degrees int
+		want    int
+	}{
+		{0, 0},
+		{90, 90},
+		{180, 180},
+		{270, 270},
+		{360, 0},
+		{450, 90},
+		{-90, 270},
+		{-180, 180},
+		{-270, 90},
+		{-450, 270},
+		{44, 0},
+		{46, 90},
+		{315, 0},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, normalizeRotation(tt.degrees), "%d degrees", tt.degrees)
+	}
+}
+
+func TestParseRational(t *testing.T) {
+	assert.InDelta(t, 29.97, parseRational("30000/1001"), 0.001)
+	assert.Equal(t, 25.0, parseRational("25/1"))
+	assert.Equal(t, 0.0, parseRational("0/0"))
+	assert.Equal(t, 12.5, parseRational("12.5"))
+	assert.Equal(t, 0.0, parseRational("x/1"))
+}
@@ -0,0 +1,46 @@
+package ffmpeg
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+)
+
+var showinfoPTSPattern = regexp.MustCompile(`\] n:\s*\d+ pts:\s*-?\d+ pts_time:(-?[0-9.]+)`)
+
+// DetectScenes returns the timestamps, in the stream time base, of the frames
+// whose scene change score exceeds threshold. The score is ffmpeg's
+// normalised difference to the previous frame, from 0 to 1.
+func (s *ffmpegService) DetectScenes(ctx context.Context, videoPath string, threshold float64) ([]float64, error) {
+	cmd := exec.CommandContext(ctx, "ffmpeg",
+		"-i", videoPath,
+		"-map", "0:v:0",
+		"-vf", fmt.Sprintf("select='gt(scene,%.3f)',showinfo", threshold),
+		"-an",
+		"-f", "null",
+		"-",
+	)
+
+	output, err := s.combinedOutput(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg failed: %w, output: %s", err, string(output))
+	}
+
+	return parseSceneChanges(string(output))
+}
+
+// parseSceneChanges extracts the pts_time of every frame reported by the
+// showinfo filter.
+func parseSceneChanges(output string) ([]float64, error) {
+	var times []float64
+	for _, match := range showinfoPTSPattern.FindAllStringSubmatch(output, -1) {
+		t, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse scene change time: %w", err)
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
@@ -0,0 +1,48 @@
+package ffmpeg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// showinfoOutput is trimmed ffmpeg stderr from a scene detection run.
+const showinfoOutput = `Input #0, mov,mp4,m4a,3gp,3g2,mj2, from 'input.mp4':
+  Duration: 00:00:30.03, start: 0.000000, bitrate: 2125 kb/s
+[Parsed_showinfo_1 @ 0x55d5c0a3c2c0] config in time_base: 1/24000, frame_rate: 24000/1001
+[Parsed_showinfo_1 @ 0x55d5c0a3c2c0] config out time_base: 0/0, frame_rate: 0/0
+[Parsed_showinfo_1 @ 0x55d5c0a3c2c0] n:   0 pts:  48048 pts_time:2.002   duration:   1001 duration_time:0.0417083 fmt:yuv420p sar:1/1 s:1920x1080 i:P iskey:0 type:P checksum:3C4B1D2E plane_checksum:[0A1B2C3D 1E2F3A4B 5C6D7E8F] mean:[112 127 129] stdev:[61.4 4.2 5.0]
+[Parsed_showinfo_1 @ 0x55d5c0a3c2c0] n:   1 pts: 300300 pts_time:12.5125 duration:   1001 duration_time:0.0417083 fmt:yuv420p sar:1/1 s:1920x1080 i:P iskey:1 type:I checksum:9F8E7D6C plane_checksum:[1A2B3C4D 5E6F7A8B 9C0D1E2F] mean:[98 126 131] stdev:[55.0 3.9 4.8]
+[Parsed_showinfo_1 @ 0x55d5c0a3c2c0] n:   2 pts: 661661 pts_time:27.5694 duration:   1001 duration_time:0.0417083 fmt:yuv420p sar:1/1 s:1920x1080 i:P iskey:0 type:B checksum:0F1E2D3C plane_checksum:[4B5A6978 8796A5B4 C3D2E1F0] mean:[140 125 130] stdev:[48.2 4.4 5.1]
+frame=    3 fps=0.0 q=-0.0 Lsize=N/A time=00:00:30.01 bitrate=N/A speed=  95x
+`
+
+func TestParseSceneChanges(t *testing.T) {
+	times, err := parseSceneChanges(showinfoOutput)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{2.002, 12.5125, 27.5694}, times)
+}
+
+func TestParseSceneChanges_NegativeStart(t *testing.T) {
+	times, err := parseSceneChanges("[Parsed_showinfo_1 @ 0x1] n:   0 pts:  -1001 pts_time:-0.0417083 duration:   1001\n")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{-0.0417083}, times)
+}
+
+func TestParseSceneChanges_NoChanges(t *testing.T) {
+	for _, output := range []string{"", "frame=    0 fps=0.0 q=-0.0 Lsize=N/A time=00:00:30.01 bitrate=N/A\n"} {
+		times, err := parseSceneChanges(output)
+
+		assert.NoError(t, err)
+		assert.Empty(t, times)
+	}
+}
+
+func TestParseSceneChanges_Malformed(t *testing.T) {
+	times, err := parseSceneChanges("[Parsed_showinfo_1 @ 0x1] n:   0 pts:  48048 pts_time:2.0.2 duration:   1001\n")
+
+	assert.Error(t, err)
+	assert.Nil(t, times)
+}
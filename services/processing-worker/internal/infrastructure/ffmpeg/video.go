@@ -0,0 +1,157 @@
+package ffmpeg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	DeinterlaceAuto  = "auto"
+	DeinterlaceOff   = "off"
+	DeinterlaceYadif = "yadif"
+	DeinterlaceBwdif = "bwdif"
+
+	ToneMapAuto = "auto"
+	ToneMapOff  = "off"
+	ToneMapOn   = "on"
+)
+
+var ErrNoVideoStream = errors.New("source has no video stream")
+
+// hdrTransfers are the PQ and HLG transfer characteristics of HDR video.
+var hdrTransfers = map[string]bool{
+	"smpte2084":    true,
+	"arib-std-b67": true,
+}
+
+// toneMapFilter converts HDR to BT.709 SDR: linearize, map the highlights
+// down with hable, then encode back to limited range BT.709.
+const toneMapFilter = "zscale=t=linear:npl=100,format=gbrpf32le,zscale=p=bt709," +
+	"tonemap=tonemap=hable:desat=0,zscale=t=bt709:m=bt709:r=tv,format=yuv420p"
+
+// IsInterlaced reports whether the stream is flagged as field coded.
+func (s Stream) IsInterlaced() bool {
+	switch s.FieldOrder {
+	case "tt", "bb", "tb", "bt":
+		return true
+	}
+	return false
+}
+
+func (s Stream) IsHDR() bool {
+	return hdrTransfers[s.ColorTransfer]
+}
+
+// VideoSettings are the requested stream and picture corrections. A nil
+// StreamIndex picks the first video stream and a nil Rotation honors the
+// container's rotation metadata; Deinterlace and ToneMap default to auto,
+// which applies them only when the probe calls for it.
+type VideoSettings struct {
+	StreamIndex *int
+	Rotation    *int
+	Deinterlace string
+	ToneMap     string
+}
+
+// VideoPipeline is the resolved decoding setup for one source. Rotation is
+// applied explicitly, so ffmpeg's own autorotation is turned off.
+type VideoPipeline struct {
+	StreamIndex int
+	Rotation    int
+	Deinterlace string
+	ToneMap     bool
+}
+
+// ResolveVideoPipeline checks the settings against the probe and decides
+// which corrections the source needs.
+func ResolveVideoPipeline(probe *ProbeResult, settings VideoSettings) (*VideoPipeline, error) {
+	stream := probe.VideoStream()
+	if settings.StreamIndex != nil {
+		stream = nil
+		for i := range probe.Streams {
+			if probe.Streams[i].Index == *settings.StreamIndex {
+				stream = &probe.Streams[i]
+			}
+		}
+		if stream == nil || stream.CodecType != "video" {
+			return nil, fmt.Errorf("stream %d is not a video stream", *settings.StreamIndex)
+		}
+	}
+	if stream == nil {
+		return nil, ErrNoVideoStream
+	}
+
+	pipeline := &VideoPipeline{
+		StreamIndex: stream.Index,
+		Rotation:    stream.Rotation,
+	}
+
+	if settings.Rotation != nil {
+		pipeline.Rotation = normalizeRotation(*settings.Rotation)
+	}
+
+	switch settings.Deinterlace {
+	case "", DeinterlaceAuto:
+		if stream.IsInterlaced() {
+			pipeline.Deinterlace = DeinterlaceYadif
+		}
+	case DeinterlaceYadif, DeinterlaceBwdif:
+		pipeline.Deinterlace = settings.Deinterlace
+	case DeinterlaceOff:
+	default:
+		return nil, fmt.Errorf("unsupported deinterlace mode: %s", settings.Deinterlace)
+	}
+
+	switch settings.ToneMap {
+	case "", ToneMapAuto:
+		pipeline.ToneMap = stream.IsHDR()
+	case ToneMapOn:
+		pipeline.ToneMap = true
+	case ToneMapOff:
+	default:
+		return nil, fmt.Errorf("unsupported tone mapping mode: %s", settings.ToneMap)
+	}
+
+	return pipeline, nil
+}
+
+// DisplaySize is the size of the stream's frames once rotated.
+func (p *VideoPipeline) DisplaySize(stream *Stream) (width, height int) {
+	if p.Rotation == 90 || p.Rotation == 270 {
+		return stream.Height, stream.Width
+	}
+	return stream.Width, stream.Height
+}
+
+// inputLabel names the selected stream in a filter graph.
+func (p *VideoPipeline) inputLabel() string {
+	return fmt.Sprintf("[0:%d]", p.StreamIndex)
+}
+
+// chain wraps the sampling filter, if any, with the corrections.
+// Deinterlacing runs before sampling, while neighboring fields are still
+// available; the rest runs on the sampled frames only.
+func (p *VideoPipeline) chain(sampling string) string {
+	var filters []string
+	if p.Deinterlace != "" {
+		filters = append(filters, p.Deinterlace)
+	}
+	if sampling != "" {
+		filters = append(filters, sampling)
+	}
+	if p.ToneMap {
+		filters = append(filters, toneMapFilter)
+	}
+
+	switch p.Rotation {
+	case 90:
+		filters = append(filters, "transpose=clock")
+	case 180:
+		filters = append(filters, "hflip,vflip")
+	case 270:
+		filters = append(filters, "transpose=cclock")
+	}
+
+	return strings.Join(filters, ",")
+}
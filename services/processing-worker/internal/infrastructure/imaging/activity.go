@@ -0,0 +1,37 @@
+package imaging
+
+import (
+	"image"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+const (
+	activityThumbWidth  = 160
+	activityThumbHeight = 90
+
+	// activityNoiseFloor is the luma change below which a pixel counts as
+	// unchanged. Downscaling already averages out most sensor noise.
+	activityNoiseFloor = 20
+)
+
+// ActivityThumbnail reduces img to the small grayscale picture that
+// ChangedPercent compares.
+func ActivityThumbnail(img image.Image) *image.Gray {
+	small := image.NewGray(image.Rect(0, 0, activityThumbWidth, activityThumbHeight))
+	xdraw.ApproxBiLinear.Scale(small, small.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+	return small
+}
+
+// ChangedPercent returns the percentage, from 0 to 100, of pixels whose luma
+// differs noticeably between two activity thumbnails.
+func ChangedPercent(a, b *image.Gray) int {
+	changed := 0
+	for i := range a.Pix {
+		d := int(a.Pix[i]) - int(b.Pix[i])
+		if d > activityNoiseFloor || d < -activityNoiseFloor {
+			changed++
+		}
+	}
+	return (changed*100 + len(a.Pix)/2) / len(a.Pix)
+}
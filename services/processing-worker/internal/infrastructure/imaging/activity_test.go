@@ -0,0 +1,53 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// brighten returns a copy of img with luma raised by delta in the columns
+// left of x.
+func brighten(img *image.Gray, x int, delta uint8) *image.Gray {
+	out := image.NewGray(img.Bounds())
+	copy(out.Pix, img.Pix)
+	for py := 0; py < out.Bounds().Dy(); py++ {
+		for px := 0; px < x; px++ {
+			out.SetGray(px, py, color.Gray{Y: img.GrayAt(px, py).Y + delta})
+		}
+	}
+	return out
+}
+
+func TestActivityThumbnail(t *testing.T) {
+	thumb := ActivityThumbnail(uniformImage(1920, 1080, 90))
+
+	assert.Equal(t, image.Rect(0, 0, activityThumbWidth, activityThumbHeight), thumb.Bounds())
+	assert.Equal(t, uint8(90), thumb.GrayAt(80, 45).Y)
+}
+
+func TestChangedPercent(t *testing.T) {
+	still := ActivityThumbnail(uniformImage(640, 360, 100))
+
+	tests := []struct {
+		name string
+		next *image.Gray
+		want int
+	}{
+		{"identical", still, 0},
+		{"sensor noise below the floor", brighten(still, activityThumbWidth, activityNoiseFloor), 0},
+		{"left quarter changed", brighten(still, activityThumbWidth/4, 60), 25},
+		{"left half changed", brighten(still, activityThumbWidth/2, 60), 50},
+		{"whole frame changed", brighten(still, activityThumbWidth, 60), 100},
+		{"one column rounds to the nearest percent", brighten(still, 1, 60), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ChangedPercent(still, tt.next))
+			assert.Equal(t, tt.want, ChangedPercent(tt.next, still))
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+const (
+	// HistogramBins is the number of bins per RGB channel.
+	HistogramBins = 8
+
+	colorSampleWidth  = 64
+	colorSampleHeight = 36
+	kmeansIterations  = 10
+)
+
+// DominantColor is a cluster centre with the share of pixels it covers.
+type DominantColor struct {
+	Hex    string  `json:"hex"`
+	R      uint8   `json:"-"`
+	G      uint8   `json:"-"`
+	B      uint8   `json:"-"`
+	Weight float64 `json:"weight"`
+}
+
+// ColorProfile holds the normalised per-channel histograms, in R, G, B
+// order, and the dominant colors sorted by weight.
+type ColorProfile struct {
+	Histogram [3][HistogramBins]float64 `json:"histogram"`
+	Dominant  []DominantColor           `json:"dominant"`
+}
+
+// Colors computes the color profile of img on a downscaled copy, finding k
+// dominant colors with k-means.
+func Colors(img image.Image, k int) ColorProfile {
+	small := image.NewRGBA(image.Rect(0, 0, colorSampleWidth, colorSampleHeight))
+	xdraw.ApproxBiLinear.Scale(small, small.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+
+	pixels := make([][3]float64, 0, colorSampleWidth*colorSampleHeight)
+	var profile ColorProfile
+	for i := 0; i < len(small.Pix); i += 4 {
+		px := [3]float64{float64(small.Pix[i]), float64(small.Pix[i+1]), float64(small.Pix[i+2])}
+		pixels = append(pixels, px)
+		for c := 0; c < 3; c++ {
+			profile.Histogram[c][int(px[c])*HistogramBins/256]++
+		}
+	}
+
+	for c := 0; c < 3; c++ {
+		for b := range profile.Histogram[c] {
+			profile.Histogram[c][b] = roundTo(profile.Histogram[c][b]/float64(len(pixels)), 4)
+		}
+	}
+
+	profile.Dominant = kmeans(pixels, k)
+	return profile
+}
+
+// kmeans clusters the pixels starting from deterministic maximin seeds: the
+// mean colour, then repeatedly the pixel farthest from every chosen centre.
+// Identical input therefore always yields identical colors.
+func kmeans(pixels [][3]float64, k int) []DominantColor {
+	if len(pixels) == 0 || k <= 0 {
+		return nil
+	}
+
+	centers := [][3]float64{meanColor(pixels)}
+	for len(centers) < k {
+		best, bestDistance := -1, 0.0
+		for i, px := range pixels {
+			_, d := nearest(centers, px)
+			if d > bestDistance {
+				best, bestDistance = i, d
+			}
+		}
+		if best < 0 {
+			break
+		}
+		centers = append(centers, pixels[best])
+	}
+
+	assignment := make([]int, len(pixels))
+	for iter := 0; iter < kmeansIterations; iter++ {
+		changed := iter == 0
+		for i, px := range pixels {
+			if c, _ := nearest(centers, px); c != assignment[i] {
+				assignment[i] = c
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([][3]float64, len(centers))
+		counts := make([]int, len(centers))
+		for i, px := range pixels {
+			c := assignment[i]
+			for ch := 0; ch < 3; ch++ {
+				sums[c][ch] += px[ch]
+			}
+			counts[c]++
+		}
+		for c := range centers {
+			if counts[c] == 0 {
+				continue
+			}
+			for ch := 0; ch < 3; ch++ {
+				centers[c][ch] = sums[c][ch] / float64(counts[c])
+			}
+		}
+	}
+
+	counts := make([]int, len(centers))
+	for _, c := range assignment {
+		counts[c]++
+	}
+
+	colors := make([]DominantColor, 0, len(centers))
+	for c, center := range centers {
+		if counts[c] == 0 {
+			continue
+		}
+		r, g, b := uint8(math.Round(center[0])), uint8(math.Round(center[1])), uint8(math.Round(center[2]))
+		colors = append(colors, DominantColor{
+			Hex:    HexColor(color.RGBA{R: r, G: g, B: b}),
+			R:      r,
+			G:      g,
+			B:      b,
+			Weight: roundTo(float64(counts[c])/float64(len(pixels)), 4),
+		})
+	}
+
+	sort.SliceStable(colors, func(i, j int) bool { return colors[i].Weight > colors[j].Weight })
+	return colors
+}
+
+func HexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func meanColor(pixels [][3]float64) [3]float64 {
+	var sum [3]float64
+	for _, px := range pixels {
+		for ch := 0; ch < 3; ch++ {
+			sum[ch] += px[ch]
+		}
+	}
+	for ch := 0; ch < 3; ch++ {
+		sum[ch] /= float64(len(pixels))
+	}
+	return sum
+}
+
+func nearest(centers [][3]float64, px [3]float64) (int, float64) {
+	best, bestDistance := 0, math.MaxFloat64
+	for i, c := range centers {
+		d := (c[0]-px[0])*(c[0]-px[0]) + (c[1]-px[1])*(c[1]-px[1]) + (c[2]-px[2])*(c[2]-px[2])
+		if d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return best, bestDistance
+}
+
+func roundTo(v float64, decimals int) float64 {
+	p := math.Pow(10, float64(decimals))
+	return math.Round(v*p) / p
+}
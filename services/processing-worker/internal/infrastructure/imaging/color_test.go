@@ -0,0 +1,72 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// twoColorImage fills the left three quarters with left and the rest with
+// right. It is drawn at the sample size, so no pixel is blended.
+func twoColorImage(left, right color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, colorSampleWidth, colorSampleHeight))
+	split := colorSampleWidth * 3 / 4
+	draw.Draw(img, image.Rect(0, 0, split, colorSampleHeight), image.NewUniform(left), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(split, 0, colorSampleWidth, colorSampleHeight), image.NewUniform(right), image.Point{}, draw.Src)
+	return img
+}
+
+func TestColors_TwoColorImage(t *testing.T) {
+	red := color.RGBA{R: 220, G: 20, B: 60, A: 255}
+	blue := color.RGBA{R: 30, G: 60, B: 200, A: 255}
+
+	profile := Colors(twoColorImage(red, blue), 2)
+
+	assert.Equal(t, []DominantColor{
+		{Hex: "#dc143c", R: 220, G: 20, B: 60, Weight: 0.75},
+		{Hex: "#1e3cc8", R: 30, G: 60, B: 200, Weight: 0.25},
+	}, profile.Dominant)
+
+	// 220 and 30 fall in red bins 6 and 0.
+	assert.Equal(t, 0.75, profile.Histogram[0][6])
+	assert.Equal(t, 0.25, profile.Histogram[0][0])
+	for c := 0; c < 3; c++ {
+		var total float64
+		for _, share := range profile.Histogram[c] {
+			total += share
+		}
+		assert.InDelta(t, 1, total, 1e-9)
+	}
+}
+
+func TestColors_MoreClustersThanColors(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	profile := Colors(twoColorImage(red, blue), 5)
+
+	assert.Len(t, profile.Dominant, 2)
+	assert.Equal(t, "#ff0000", profile.Dominant[0].Hex)
+	assert.Equal(t, "#0000ff", profile.Dominant[1].Hex)
+}
+
+func TestColors_Deterministic(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 320, 180))
+	for y := 0; y < 180; y++ {
+		for x := 0; x < 320; x++ {
+			img.SetRGBA(x, y, color.RGBA{R: uint8(x * 255 / 320), G: uint8(y * 255 / 180), B: 128, A: 255})
+		}
+	}
+
+	first := Colors(img, 4)
+
+	assert.Len(t, first.Dominant, 4)
+	assert.Equal(t, first, Colors(img, 4))
+}
+
+func TestColors_NoClusters(t *testing.T) {
+	assert.Empty(t, Colors(uniformImage(16, 16, 0), 0).Dominant)
+}
@@ -0,0 +1,31 @@
+package imaging
+
+import (
+	"image"
+	"math/bits"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+// DHash computes a 64-bit difference hash: the image is reduced to a 9x8
+// grayscale thumbnail and each bit records whether a pixel is brighter than
+// its right-hand neighbour.
+func DHash(img image.Image) uint64 {
+	small := image.NewGray(image.Rect(0, 0, 9, 8))
+	xdraw.ApproxBiLinear.Scale(small, small.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			hash <<= 1
+			if small.GrayAt(x, y).Y > small.GrayAt(x+1, y).Y {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
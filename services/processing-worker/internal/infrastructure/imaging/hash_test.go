@@ -0,0 +1,69 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xdraw "golang.org/x/image/draw"
+)
+
+// sceneImage draws a smooth, asymmetric luma pattern panned dx pixels to
+// the left, so that panned copies differ in every pixel but hardly in
+// content.
+func sceneImage(width, height, dx int) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			fx, fy := float64(x+dx)/float64(width), float64(y)/float64(height)
+			v := 128 + 120*math.Sin(2*math.Pi*(2.3*fx+1.1*fy*fy+0.7*fx*fy))
+			img.SetGray(x, y, color.Gray{Y: uint8(v)})
+		}
+	}
+	return img
+}
+
+func downscale(img image.Image, width, height int) *image.Gray {
+	out := image.NewGray(image.Rect(0, 0, width, height))
+	xdraw.BiLinear.Scale(out, out.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+	return out
+}
+
+func mirror(img *image.Gray) *image.Gray {
+	b := img.Bounds()
+	out := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			out.SetGray(b.Max.X-1-x, y, img.GrayAt(x, y))
+		}
+	}
+	return out
+}
+
+func TestDHash_Identical(t *testing.T) {
+	assert.Equal(t, DHash(sceneImage(640, 360, 0)), DHash(sceneImage(640, 360, 0)))
+}
+
+func TestDHash_NearDuplicates(t *testing.T) {
+	hash := DHash(sceneImage(640, 360, 0))
+
+	// A slight pan and a downscaled copy stay within the default dedupe
+	// distance of 5 bits.
+	assert.LessOrEqual(t, HammingDistance(hash, DHash(sceneImage(640, 360, 4))), 5)
+	assert.LessOrEqual(t, HammingDistance(hash, DHash(downscale(sceneImage(640, 360, 0), 320, 180))), 5)
+}
+
+func TestDHash_DifferentImages(t *testing.T) {
+	img := sceneImage(640, 360, 0)
+
+	assert.Greater(t, HammingDistance(DHash(img), DHash(mirror(img))), 5)
+	assert.Greater(t, HammingDistance(DHash(img), DHash(sceneImage(640, 360, 140))), 5)
+}
+
+func TestHammingDistance(t *testing.T) {
+	assert.Equal(t, 0, HammingDistance(0xdeadbeef, 0xdeadbeef))
+	assert.Equal(t, 1, HammingDistance(0, 1<<63))
+	assert.Equal(t, 64, HammingDistance(0, math.MaxUint64))
+}
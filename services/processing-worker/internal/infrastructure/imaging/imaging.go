@@ -0,0 +1,117 @@
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"os"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const captionPadding = 3
+
+// Cell is a single tile of a grid image with an optional caption.
+type Cell struct {
+	Image   image.Image
+	Caption string
+}
+
+func DecodeFile(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image %s: %w", path, err)
+	}
+	return img, nil
+}
+
+func EncodeJPEGFile(path string, img image.Image, quality int) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := jpeg.Encode(file, img, &jpeg.Options{Quality: quality}); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to encode image %s: %w", path, err)
+	}
+	return file.Close()
+}
+
+// Fit scales img to fit inside a width x height box, preserving the aspect
+// ratio and letterboxing the remainder in black.
+func Fit(img image.Image, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.Black), image.Point{}, draw.Src)
+
+	src := img.Bounds()
+	if src.Dx() == 0 || src.Dy() == 0 {
+		return dst
+	}
+
+	scaledW, scaledH := width, src.Dy()*width/src.Dx()
+	if scaledH > height {
+		scaledW, scaledH = src.Dx()*height/src.Dy(), height
+	}
+
+	offset := image.Pt((width-scaledW)/2, (height-scaledH)/2)
+	xdraw.ApproxBiLinear.Scale(dst, image.Rectangle{Min: offset, Max: offset.Add(image.Pt(scaledW, scaledH))}, img, src, xdraw.Src, nil)
+	return dst
+}
+
+// Grid lays cells out row by row on a canvas of columns x rows tiles, each
+// tile being width x height pixels.
+func Grid(cells []Cell, columns, rows, width, height int) *image.RGBA {
+	canvas := image.NewRGBA(image.Rect(0, 0, columns*width, rows*height))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(color.Black), image.Point{}, draw.Src)
+
+	for i, cell := range cells {
+		if i >= columns*rows {
+			break
+		}
+
+		origin := image.Pt((i%columns)*width, (i/columns)*height)
+		tile := Fit(cell.Image, width, height)
+		draw.Draw(canvas, tile.Bounds().Add(origin), tile, image.Point{}, draw.Src)
+
+		if cell.Caption != "" {
+			drawCaption(canvas, origin, height, cell.Caption)
+		}
+	}
+
+	return canvas
+}
+
+func drawCaption(dst draw.Image, origin image.Point, cellHeight int, text string) {
+	face := basicfont.Face7x13
+	textWidth := font.MeasureString(face, text).Ceil()
+	metrics := face.Metrics()
+	textHeight := (metrics.Ascent + metrics.Descent).Ceil()
+
+	box := image.Rect(
+		origin.X,
+		origin.Y+cellHeight-textHeight-2*captionPadding,
+		origin.X+textWidth+2*captionPadding,
+		origin.Y+cellHeight,
+	)
+	draw.Draw(dst, box, image.NewUniform(color.RGBA{A: 160}), image.Point{}, draw.Over)
+
+	drawer := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(color.White),
+		Face: face,
+		Dot:  fixed.P(box.Min.X+captionPadding, box.Max.Y-captionPadding-metrics.Descent.Ceil()),
+	}
+	drawer.DrawString(text)
+}
@@ -0,0 +1,74 @@
+package imaging
+
+import (
+	"image"
+	"image/draw"
+)
+
+// QualityScores summarises how usable a frame is: mean luminance catches
+// black frames, luminance variance catches blank ones and the variance of
+// the Laplacian catches blur.
+type QualityScores struct {
+	Luminance float64 `json:"luminance"`
+	Variance  float64 `json:"variance"`
+	Sharpness float64 `json:"sharpness"`
+}
+
+func Quality(img image.Image) QualityScores {
+	gray, ok := img.(*image.Gray)
+	if !ok {
+		gray = image.NewGray(img.Bounds())
+		draw.Draw(gray, gray.Bounds(), img, img.Bounds().Min, draw.Src)
+	}
+
+	b := gray.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w == 0 || h == 0 {
+		return QualityScores{}
+	}
+
+	var sum, sumSq float64
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		row := gray.Pix[gray.PixOffset(b.Min.X, y):]
+		for x := 0; x < w; x++ {
+			v := float64(row[x])
+			sum += v
+			sumSq += v * v
+		}
+	}
+	n := float64(w * h)
+	mean := sum / n
+
+	return QualityScores{
+		Luminance: mean,
+		Variance:  sumSq/n - mean*mean,
+		Sharpness: laplacianVariance(gray),
+	}
+}
+
+// laplacianVariance applies the 4-neighbour Laplacian kernel to the interior
+// pixels and returns the variance of the response.
+func laplacianVariance(gray *image.Gray) float64 {
+	b := gray.Bounds()
+	if b.Dx() < 3 || b.Dy() < 3 {
+		return 0
+	}
+
+	at := func(x, y int) float64 {
+		return float64(gray.Pix[gray.PixOffset(x, y)])
+	}
+
+	var sum, sumSq float64
+	var n int
+	for y := b.Min.Y + 1; y < b.Max.Y-1; y++ {
+		for x := b.Min.X + 1; x < b.Max.X-1; x++ {
+			v := at(x-1, y) + at(x+1, y) + at(x, y-1) + at(x, y+1) - 4*at(x, y)
+			sum += v
+			sumSq += v * v
+			n++
+		}
+	}
+
+	mean := sum / float64(n)
+	return sumSq/float64(n) - mean*mean
+}
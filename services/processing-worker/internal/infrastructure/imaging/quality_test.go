@@ -0,0 +1,123 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The worker's default frame quality thresholds.
+const (
+	minLuminance = 16
+	minVariance  = 50
+	minSharpness = 50
+)
+
+func uniformImage(width, height int, luma uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = luma
+	}
+	return img
+}
+
+// detailImage is a soft wave, standing in for an out of focus frame, with
+// an optional fine checkerboard of the given amplitude laid over it.
+func detailImage(width, height int, amplitude float64) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := 128 + 60*math.Sin(float64(x)/12)*math.Cos(float64(y)/12)
+			if (x+y)%2 == 0 {
+				v += amplitude
+			} else {
+				v -= amplitude
+			}
+			img.SetGray(x, y, color.Gray{Y: uint8(math.Round(v))})
+		}
+	}
+	return img
+}
+
+func passes(scores QualityScores) bool {
+	return scores.Luminance >= minLuminance && scores.Variance >= minVariance && scores.Sharpness >= minSharpness
+}
+
+func TestQuality_Thresholds(t *testing.T) {
+	tests := []struct {
+		name  string
+		img   image.Image
+		check func(t *testing.T, scores QualityScores)
+	}{
+		{
+			name: "black frame",
+			img:  uniformImage(320, 180, 4),
+			check: func(t *testing.T, scores QualityScores) {
+				assert.Equal(t, 4.0, scores.Luminance)
+				assert.Less(t, scores.Luminance, float64(minLuminance))
+			},
+		},
+		{
+			name: "flat frame",
+			img:  uniformImage(320, 180, 128),
+			check: func(t *testing.T, scores QualityScores) {
+				assert.Equal(t, 128.0, scores.Luminance)
+				assert.Equal(t, 0.0, scores.Variance)
+				assert.Equal(t, 0.0, scores.Sharpness)
+			},
+		},
+		{
+			name: "blurred frame",
+			img:  detailImage(320, 180, 0),
+			check: func(t *testing.T, scores QualityScores) {
+				assert.InDelta(t, 128, scores.Luminance, 2)
+				assert.GreaterOrEqual(t, scores.Variance, float64(minVariance))
+				assert.Less(t, scores.Sharpness, float64(minSharpness))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scores := Quality(tt.img)
+
+			tt.check(t, scores)
+			assert.False(t, passes(scores))
+		})
+	}
+}
+
+func TestQuality_SharpFrame(t *testing.T) {
+	scores := Quality(detailImage(320, 180, 20))
+
+	assert.InDelta(t, 128, scores.Luminance, 2)
+	assert.Greater(t, scores.Sharpness, Quality(detailImage(320, 180, 0)).Sharpness)
+	assert.True(t, passes(scores))
+}
+
+func TestQuality_ConvertsColorImages(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = 255, 255, 255, 255
+	}
+
+	assert.Equal(t, QualityScores{Luminance: 255}, Quality(img))
+}
+
+func TestLaplacianVariance(t *testing.T) {
+	// One bright pixel in the middle of a 3x3 image: the only interior
+	// response is -4 * 100, whose variance around its own mean is 0.
+	spot := uniformImage(3, 3, 0)
+	spot.SetGray(1, 1, color.Gray{Y: 100})
+	assert.Equal(t, 0.0, laplacianVariance(spot))
+
+	// On a 4x3 image the spot gives responses of -400 and 100.
+	spot = uniformImage(4, 3, 0)
+	spot.SetGray(1, 1, color.Gray{Y: 100})
+	assert.Equal(t, 62500.0, laplacianVariance(spot))
+
+	assert.Equal(t, 0.0, laplacianVariance(uniformImage(2, 2, 255)))
+}
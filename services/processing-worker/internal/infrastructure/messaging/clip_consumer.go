@@ -0,0 +1,89 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/controller"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/registry"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+)
+
+type ClipRangeMessage struct {
+	ClipID string  `json:"clip_id"`
+	Start  float64 `json:"start"`
+	End    float64 `json:"end"`
+}
+
+type ClipJobMessage struct {
+	VideoID  string             `json:"video_id"`
+	UserID   int64              `json:"user_id"`
+	S3Key    string             `json:"s3_key"`
+	Filename string             `json:"filename"`
+	Clips    []ClipRangeMessage `json:"clips"`
+}
+
+type ClipConsumer struct {
+	consumer   *rabbitmq.Consumer
+	controller controller.WorkerController
+	registry   *registry.Registry
+}
+
+func NewClipConsumer(consumer *rabbitmq.Consumer, controller controller.WorkerController, registry *registry.Registry) *ClipConsumer {
+	return &ClipConsumer{
+		consumer:   consumer,
+		controller: controller,
+		registry:   registry,
+	}
+}
+
+func (cc *ClipConsumer) Start(ctx context.Context) error {
+	logging.Info("Starting clip consumer")
+
+	return cc.consumer.Consume(ctx, "video.clip.queue", func(body []byte) error {
+		var msg ClipJobMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			logging.Error("Failed to unmarshal message", "error", err)
+			return err
+		}
+
+		videoID, err := uuid.Parse(msg.VideoID)
+		if err != nil {
+			logging.Error("Invalid video ID", "video_id", msg.VideoID, "error", err)
+			return err
+		}
+
+		clips := make([]commands.ClipRange, len(msg.Clips))
+		for i, clip := range msg.Clips {
+			clipID, err := uuid.Parse(clip.ClipID)
+			if err != nil {
+				logging.Error("Invalid clip ID", "clip_id", clip.ClipID, "error", err)
+				return err
+			}
+			clips[i] = commands.ClipRange{ClipID: clipID, Start: clip.Start, End: clip.End}
+		}
+
+		cmd := commands.ClipCommand{
+			VideoID:  videoID,
+			UserID:   msg.UserID,
+			S3Key:    msg.S3Key,
+			Filename: msg.Filename,
+			Clips:    clips,
+		}
+
+		logging.Info("Processing clip job", "video_id", videoID, "clips", len(clips))
+
+		done := cc.registry.Track(ctx, registry.Job{Type: "clip", ID: videoID.String(), VideoID: videoID.String(), UserID: msg.UserID})
+		defer done()
+
+		if err := cc.controller.ClipVideo(ctx, cmd); err != nil {
+			logging.Error("Failed to cut clips", "video_id", videoID, "error", err)
+			return err
+		}
+
+		return nil
+	})
+}
@@ -3,63 +3,150 @@ package messaging
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/video-platform/services/processing-worker/internal/controller"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/fairness"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/registry"
 	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
 	"github.com/video-platform/shared/pkg/logging"
 	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
 )
 
+const processingQueue = "video.processing.priority"
+
+// legacyProcessingQueue is the queue jobs went to before they had
+// priorities. Workers drain it so jobs queued before an upgrade still run.
+const legacyProcessingQueue = "video.processing.queue"
+
 type VideoJobMessage struct {
-	VideoID  string `json:"video_id"`
-	UserID   int64  `json:"user_id"`
-	S3Key    string `json:"s3_key"`
-	Filename string `json:"filename"`
+	VideoID  string                     `json:"video_id"`
+	UserID   int64                      `json:"user_id"`
+	S3Key    string                     `json:"s3_key"`
+	Filename string                     `json:"filename"`
+	FileSize int64                      `json:"file_size"`
+	Options  commands.ProcessingOptions `json:"options"`
+	Priority uint8                      `json:"priority"`
+}
+
+// jobTracker lists the jobs the worker is running in its registry entry.
+type jobTracker interface {
+	Track(ctx context.Context, job registry.Job) func()
+}
+
+// jobLimiter caps how many jobs of one user run at once.
+type jobLimiter interface {
+	Acquire(ctx context.Context, userID int64, jobID string) (func(), bool, error)
 }
 
 type VideoConsumer struct {
 	consumer   *rabbitmq.Consumer
 	controller controller.WorkerController
+	registry   jobTracker
+	limiter    jobLimiter
+	publisher  rabbitmq.Publisher
+
+	// deferDelay is how long a job waits before it is redelivered when its
+	// user already has as many jobs running as the limiter allows.
+	deferDelay time.Duration
+
+	// slot lets one job at a time run, whichever queue it came from, so the
+	// worker stays within the capacity it registers.
+	slot chan struct{}
 }
 
-func NewVideoConsumer(consumer *rabbitmq.Consumer, controller controller.WorkerController) *VideoConsumer {
+func NewVideoConsumer(consumer *rabbitmq.Consumer, controller controller.WorkerController, registry *registry.Registry, limiter *fairness.Limiter, publisher rabbitmq.Publisher, deferDelay time.Duration) *VideoConsumer {
 	return &VideoConsumer{
 		consumer:   consumer,
 		controller: controller,
+		registry:   registry,
+		limiter:    limiter,
+		publisher:  publisher,
+		deferDelay: deferDelay,
+		slot:       make(chan struct{}, 1),
 	}
 }
 
 func (vc *VideoConsumer) Start(ctx context.Context) error {
 	logging.Info("Starting video processing consumer")
 
-	return vc.consumer.Consume(ctx, "video.processing.queue", func(body []byte) error {
-		var msg VideoJobMessage
-		if err := json.Unmarshal(body, &msg); err != nil {
-			logging.Error("Failed to unmarshal message", "error", err)
-			return err
-		}
+	handler := func(body []byte) error {
+		return vc.handle(ctx, body)
+	}
 
-		videoID, err := uuid.Parse(msg.VideoID)
-		if err != nil {
-			logging.Error("Invalid video ID", "video_id", msg.VideoID, "error", err)
-			return err
+	go func() {
+		if err := vc.consumer.Consume(ctx, legacyProcessingQueue, handler); err != nil && !errors.Is(err, context.Canceled) {
+			logging.Error("Legacy video queue consumer stopped", "error", err)
 		}
+	}()
 
-		cmd := commands.ProcessCommand{
-			VideoID:  videoID,
-			UserID:   msg.UserID,
-			S3Key:    msg.S3Key,
-			Filename: msg.Filename,
-		}
+	return vc.consumer.Consume(ctx, processingQueue, handler)
+}
 
-		logging.Info("Processing video job", "video_id", videoID)
+// handle runs one job. An error requeues the message; jobs that failed for
+// good, such as a rejected source, are recorded by the use case and acked.
+func (vc *VideoConsumer) handle(ctx context.Context, body []byte) error {
+	var msg VideoJobMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		logging.Error("Failed to unmarshal message", "error", err)
+		return err
+	}
+
+	videoID, err := uuid.Parse(msg.VideoID)
+	if err != nil {
+		logging.Error("Invalid video ID", "video_id", msg.VideoID, "error", err)
+		return err
+	}
 
-		if err := vc.controller.ProcessVideo(ctx, cmd); err != nil {
-			logging.Error("Failed to process video", "video_id", videoID, "error", err)
-			return err
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   msg.UserID,
+		S3Key:    msg.S3Key,
+		Filename: msg.Filename,
+		FileSize: msg.FileSize,
+		Options:  msg.Options,
+	}
+
+	release, ok, err := vc.limiter.Acquire(ctx, msg.UserID, videoID.String())
+	switch {
+	case err != nil:
+		logging.Warn("Failed to check user job limit, processing anyway", "video_id", videoID, "error", err)
+	case !ok:
+		// Hand the job back behind other users' jobs instead of holding
+		// this worker until one of the user's jobs finishes.
+		logging.Info("User job limit reached, deferring video job", "video_id", videoID, "user_id", msg.UserID)
+		err := vc.publisher.PublishWithOptions(ctx, processingQueue, json.RawMessage(body), rabbitmq.PublishOptions{
+			Priority: msg.Priority,
+			Delay:    vc.deferDelay,
+		})
+		if err == nil {
+			return nil
 		}
+		// Requeueing would bring the job straight back here, so run it over
+		// the limit instead.
+		logging.Warn("Failed to defer video job, processing anyway", "video_id", videoID, "error", err)
+	default:
+		defer release()
+	}
+
+	select {
+	case vc.slot <- struct{}{}:
+		defer func() { <-vc.slot }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	logging.Info("Processing video job", "video_id", videoID)
+
+	done := vc.registry.Track(ctx, registry.Job{Type: "process", ID: videoID.String(), VideoID: videoID.String(), UserID: msg.UserID})
+	defer done()
+
+	if err := vc.controller.ProcessVideo(ctx, cmd); err != nil {
+		logging.Error("Failed to process video", "video_id", videoID, "error", err)
+		return err
+	}
 
-		return nil
-	})
+	return nil
 }
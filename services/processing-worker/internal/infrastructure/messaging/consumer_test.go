@@ -0,0 +1,180 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/registry"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+)
+
+type MockWorkerController struct {
+	mock.Mock
+}
+
+func (m *MockWorkerController) ProcessVideo(ctx context.Context, cmd commands.ProcessCommand) error {
+	args := m.Called(ctx, cmd)
+	return args.Error(0)
+}
+
+func (m *MockWorkerController) RenderVideo(ctx context.Context, cmd commands.RenderCommand) error {
+	args := m.Called(ctx, cmd)
+	return args.Error(0)
+}
+
+func (m *MockWorkerController) ClipVideo(ctx context.Context, cmd commands.ClipCommand) error {
+	args := m.Called(ctx, cmd)
+	return args.Error(0)
+}
+
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, queue string, message interface{}) error {
+	args := m.Called(ctx, queue, message)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) PublishWithOptions(ctx context.Context, queue string, message interface{}, opts rabbitmq.PublishOptions) error {
+	args := m.Called(ctx, queue, message, opts)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+type stubTracker struct {
+	tracked []registry.Job
+}
+
+func (s *stubTracker) Track(ctx context.Context, job registry.Job) func() {
+	s.tracked = append(s.tracked, job)
+	return func() {}
+}
+
+type stubLimiter struct {
+	ok bool
+}
+
+func (s stubLimiter) Acquire(ctx context.Context, userID int64, jobID string) (func(), bool, error) {
+	return func() {}, s.ok, nil
+}
+
+func jobBody(t *testing.T, videoID uuid.UUID) []byte {
+	body, err := json.Marshal(VideoJobMessage{
+		VideoID:  videoID.String(),
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Priority: 5,
+	})
+	assert.NoError(t, err)
+	return body
+}
+
+func TestVideoConsumer_Handle_RejectedSourceIsAcked(t *testing.T) {
+	ctx := context.Background()
+	mockController := new(MockWorkerController)
+	tracker := &stubTracker{}
+
+	videoID := uuid.New()
+	// The use case records a rejected source as FAILED and reports success,
+	// since redelivering the job would only reject it again.
+	mockController.On("ProcessVideo", ctx, mock.MatchedBy(func(cmd commands.ProcessCommand) bool {
+		return cmd.VideoID == videoID
+	})).Return(nil).Once()
+
+	consumer := &VideoConsumer{controller: mockController, registry: tracker, limiter: stubLimiter{ok: true}, slot: make(chan struct{}, 1)}
+	err := consumer.handle(ctx, jobBody(t, videoID))
+
+	assert.NoError(t, err)
+	assert.Len(t, tracker.tracked, 1)
+	mockController.AssertExpectations(t)
+}
+
+func TestVideoConsumer_Handle_TransientFailureIsRequeued(t *testing.T) {
+	ctx := context.Background()
+	mockController := new(MockWorkerController)
+
+	videoID := uuid.New()
+	mockController.On("ProcessVideo", ctx, mock.Anything).Return(errors.New("failed to download video: connection reset"))
+
+	consumer := &VideoConsumer{controller: mockController, registry: &stubTracker{}, limiter: stubLimiter{ok: true}, slot: make(chan struct{}, 1)}
+	err := consumer.handle(ctx, jobBody(t, videoID))
+
+	assert.Error(t, err)
+}
+
+func TestVideoConsumer_Handle_DefersWhenUserAtLimit(t *testing.T) {
+	ctx := context.Background()
+	mockController := new(MockWorkerController)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	body := jobBody(t, videoID)
+	mockPublisher.On("PublishWithOptions", ctx, processingQueue, json.RawMessage(body), rabbitmq.PublishOptions{Priority: 5, Delay: 0}).Return(nil)
+
+	consumer := &VideoConsumer{controller: mockController, registry: &stubTracker{}, limiter: stubLimiter{ok: false}, publisher: mockPublisher, slot: make(chan struct{}, 1)}
+	err := consumer.handle(ctx, body)
+
+	assert.NoError(t, err)
+	mockController.AssertNotCalled(t, "ProcessVideo", mock.Anything, mock.Anything)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestVideoConsumer_Handle_ProcessesWhenDeferralFails(t *testing.T) {
+	ctx := context.Background()
+	mockController := new(MockWorkerController)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	mockPublisher.On("PublishWithOptions", ctx, processingQueue, mock.Anything, mock.Anything).Return(errors.New("channel closed"))
+	mockController.On("ProcessVideo", ctx, mock.Anything).Return(nil)
+
+	consumer := &VideoConsumer{controller: mockController, registry: &stubTracker{}, limiter: stubLimiter{ok: false}, publisher: mockPublisher, slot: make(chan struct{}, 1)}
+	err := consumer.handle(ctx, jobBody(t, videoID))
+
+	assert.NoError(t, err)
+	mockController.AssertExpectations(t)
+}
+
+func TestVideoConsumer_Handle_RunsOneJobAtATime(t *testing.T) {
+	ctx := context.Background()
+	mockController := new(MockWorkerController)
+
+	running := make(chan struct{})
+	finish := make(chan struct{})
+	mockController.On("ProcessVideo", ctx, mock.Anything).
+		Run(func(mock.Arguments) {
+			running <- struct{}{}
+			<-finish
+		}).
+		Return(nil)
+
+	consumer := &VideoConsumer{controller: mockController, registry: &stubTracker{}, limiter: stubLimiter{ok: true}, slot: make(chan struct{}, 1)}
+	for i := 0; i < 2; i++ {
+		// One handler per queue, as Start runs them.
+		go consumer.handle(ctx, jobBody(t, uuid.New()))
+	}
+
+	<-running
+	select {
+	case <-running:
+		t.Fatal("second job started while the first was running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	finish <- struct{}{}
+	<-running
+	finish <- struct{}{}
+}
@@ -0,0 +1,85 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/controller"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/registry"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+)
+
+type RenderJobMessage struct {
+	RenderID string  `json:"render_id"`
+	VideoID  string  `json:"video_id"`
+	UserID   int64   `json:"user_id"`
+	Frames   []int   `json:"frames"`
+	Format   string  `json:"format"`
+	FPS      float64 `json:"fps"`
+	Width    int     `json:"width"`
+	Height   int     `json:"height"`
+}
+
+type RenderConsumer struct {
+	consumer   *rabbitmq.Consumer
+	controller controller.WorkerController
+	registry   *registry.Registry
+}
+
+func NewRenderConsumer(consumer *rabbitmq.Consumer, controller controller.WorkerController, registry *registry.Registry) *RenderConsumer {
+	return &RenderConsumer{
+		consumer:   consumer,
+		controller: controller,
+		registry:   registry,
+	}
+}
+
+func (rc *RenderConsumer) Start(ctx context.Context) error {
+	logging.Info("Starting render consumer")
+
+	return rc.consumer.Consume(ctx, "video.render.queue", func(body []byte) error {
+		var msg RenderJobMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			logging.Error("Failed to unmarshal message", "error", err)
+			return err
+		}
+
+		renderID, err := uuid.Parse(msg.RenderID)
+		if err != nil {
+			logging.Error("Invalid render ID", "render_id", msg.RenderID, "error", err)
+			return err
+		}
+
+		videoID, err := uuid.Parse(msg.VideoID)
+		if err != nil {
+			logging.Error("Invalid video ID", "video_id", msg.VideoID, "error", err)
+			return err
+		}
+
+		cmd := commands.RenderCommand{
+			RenderID: renderID,
+			VideoID:  videoID,
+			UserID:   msg.UserID,
+			Frames:   msg.Frames,
+			Format:   msg.Format,
+			FPS:      msg.FPS,
+			Width:    msg.Width,
+			Height:   msg.Height,
+		}
+
+		logging.Info("Processing render job", "render_id", renderID, "video_id", videoID)
+
+		done := rc.registry.Track(ctx, registry.Job{Type: "render", ID: renderID.String(), VideoID: videoID.String(), UserID: msg.UserID})
+		defer done()
+
+		if err := rc.controller.RenderVideo(ctx, cmd); err != nil {
+			logging.Error("Failed to render video", "render_id", renderID, "error", err)
+			return err
+		}
+
+		return nil
+	})
+}
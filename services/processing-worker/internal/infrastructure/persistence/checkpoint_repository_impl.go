@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// checkpointBatchSize keeps each statement well below the Postgres
+// parameter limit; a long video checkpoints thousands of frames.
+const checkpointBatchSize = 1000
+
+type checkpointRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCheckpointRepository(db *gorm.DB) repositories.CheckpointRepository {
+	return &checkpointRepositoryImpl{db: db}
+}
+
+func (r *checkpointRepositoryImpl) FindByVideoID(ctx context.Context, videoID uuid.UUID) (*entities.Checkpoint, error) {
+	checkpoint := &entities.Checkpoint{}
+	db := r.db.WithContext(ctx)
+
+	if err := db.Where("video_id = ?", videoID).Order("frame_index").Find(&checkpoint.Frames).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("video_id = ?", videoID).Find(&checkpoint.Stages).Error; err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+func (r *checkpointRepositoryImpl) SaveFrames(ctx context.Context, frames []*entities.CheckpointFrame) error {
+	if len(frames) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		CreateInBatches(frames, checkpointBatchSize).Error
+}
+
+func (r *checkpointRepositoryImpl) DeleteFrames(ctx context.Context, videoID uuid.UUID, frameIndexes []int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(frameIndexes); start += checkpointBatchSize {
+			batch := frameIndexes[start:min(start+checkpointBatchSize, len(frameIndexes))]
+			if err := tx.Where("video_id = ? AND frame_index IN ?", videoID, batch).Delete(&entities.CheckpointFrame{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *checkpointRepositoryImpl) SaveStage(ctx context.Context, videoID uuid.UUID, stage string) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entities.CheckpointStage{VideoID: videoID, Stage: stage}).Error
+}
+
+func (r *checkpointRepositoryImpl) DeleteByVideoID(ctx context.Context, videoID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&entities.CheckpointFrame{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("video_id = ?", videoID).Delete(&entities.CheckpointStage{}).Error
+	})
+}
@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type clipRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewClipRepository(db *gorm.DB) repositories.ClipRepository {
+	return &clipRepositoryImpl{db: db}
+}
+
+func (r *clipRepositoryImpl) MarkAsStarted(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Clip{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     entities.StatusProcessing,
+			"started_at": time.Now(),
+		}).Error
+}
+
+func (r *clipRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus, errorMsg *string) error {
+	updates := map[string]interface{}{
+		"status": status,
+	}
+
+	if errorMsg != nil {
+		updates["error_message"] = *errorMsg
+	}
+
+	return r.db.WithContext(ctx).
+		Model(&entities.Clip{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+func (r *clipRepositoryImpl) UpdateClipComplete(ctx context.Context, id uuid.UUID, mode entities.ClipMode, outputPath string, sizeBytes int64) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Clip{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       entities.StatusCompleted,
+			"mode":         mode,
+			"output_path":  outputPath,
+			"size_bytes":   sizeBytes,
+			"completed_at": time.Now(),
+		}).Error
+}
@@ -0,0 +1,34 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+// frameColorBatchSize keeps each insert well below the Postgres parameter
+// limit; a long video has several colors for every frame.
+const frameColorBatchSize = 500
+
+type frameColorRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFrameColorRepository(db *gorm.DB) repositories.FrameColorRepository {
+	return &frameColorRepositoryImpl{db: db}
+}
+
+func (r *frameColorRepositoryImpl) ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, colors []*entities.FrameColor) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&entities.FrameColor{}).Error; err != nil {
+			return err
+		}
+		if len(colors) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(colors, frameColorBatchSize).Error
+	})
+}
@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type renderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRenderRepository(db *gorm.DB) repositories.RenderRepository {
+	return &renderRepositoryImpl{db: db}
+}
+
+func (r *renderRepositoryImpl) MarkAsStarted(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Render{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     entities.StatusProcessing,
+			"started_at": time.Now(),
+		}).Error
+}
+
+func (r *renderRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus, errorMsg *string) error {
+	updates := map[string]interface{}{
+		"status": status,
+	}
+
+	if errorMsg != nil {
+		updates["error_message"] = *errorMsg
+	}
+
+	return r.db.WithContext(ctx).
+		Model(&entities.Render{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+func (r *renderRepositoryImpl) UpdateRenderComplete(ctx context.Context, id uuid.UUID, frameCount int, outputPath string) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Render{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       entities.StatusCompleted,
+			"frame_count":  frameCount,
+			"output_path":  outputPath,
+			"completed_at": time.Now(),
+		}).Error
+}
@@ -0,0 +1,30 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type shotRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewShotRepository(db *gorm.DB) repositories.ShotRepository {
+	return &shotRepositoryImpl{db: db}
+}
+
+func (r *shotRepositoryImpl) ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, shots []*entities.Shot) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&entities.Shot{}).Error; err != nil {
+			return err
+		}
+		if len(shots) == 0 {
+			return nil
+		}
+		return tx.Create(shots).Error
+	})
+}
@@ -0,0 +1,32 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type subtitleTrackRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSubtitleTrackRepository(db *gorm.DB) repositories.SubtitleTrackRepository {
+	return &subtitleTrackRepositoryImpl{db: db}
+}
+
+// ReplaceByVideoID swaps the stored tracks of a video so that reprocessing a
+// job does not leave duplicates behind.
+func (r *subtitleTrackRepositoryImpl) ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, tracks []*entities.SubtitleTrack) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&entities.SubtitleTrack{}).Error; err != nil {
+			return err
+		}
+		if len(tracks) == 0 {
+			return nil
+		}
+		return tx.Create(tracks).Error
+	})
+}
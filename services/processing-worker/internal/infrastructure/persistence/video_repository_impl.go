@@ -59,6 +59,69 @@ func (r *videoRepositoryImpl) UpdateProcessingComplete(ctx context.Context, id u
 		}).Error
 }
 
+func (r *videoRepositoryImpl) UpdatePreviewPath(ctx context.Context, id uuid.UUID, previewPath string) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Update("preview_path", previewPath).Error
+}
+
+func (r *videoRepositoryImpl) UpdateHLSPath(ctx context.Context, id uuid.UUID, hlsPath string) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Update("hls_path", hlsPath).Error
+}
+
+func (r *videoRepositoryImpl) UpdateErrorCode(ctx context.Context, id uuid.UUID, errorCode string) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Update("error_code", errorCode).Error
+}
+
+func (r *videoRepositoryImpl) UpdateFrameFilterStats(ctx context.Context, id uuid.UUID, stats entities.FrameFilterStats) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"duplicate_frame_count":   stats.DuplicateFrames,
+			"low_quality_frame_count": stats.LowQualityFrames,
+			"inactive_frame_count":    stats.InactiveFrames,
+		}).Error
+}
+
+func (r *videoRepositoryImpl) UpdateFrameRate(ctx context.Context, id uuid.UUID, frameRate float64) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Update("frame_rate", frameRate).Error
+}
+
+func (r *videoRepositoryImpl) UpdateSourceDuration(ctx context.Context, id uuid.UUID, duration float64) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Update("source_duration", duration).Error
+}
+
+func (r *videoRepositoryImpl) UpdateActivityScores(ctx context.Context, id uuid.UUID, scores []byte) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Update("activity_scores", scores).Error
+}
+
+func (r *videoRepositoryImpl) UpdateAudioInfo(ctx context.Context, id uuid.UUID, hasAudio bool, audioPath *string) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Video{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"has_audio":  hasAudio,
+			"audio_path": audioPath,
+		}).Error
+}
+
 func (r *videoRepositoryImpl) MarkAsStarted(ctx context.Context, id uuid.UUID) error {
 	now := time.Now()
 	return r.db.WithContext(ctx).
@@ -0,0 +1,150 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/shared/pkg/database/redis"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+// KeyPrefix namespaces worker entries in Redis; the gateway lists workers
+// by scanning for it.
+const KeyPrefix = "workers:"
+
+// missedBeats is how many heartbeats an entry outlives, so a worker that
+// crashes drops out of the registry shortly after.
+const missedBeats = 3
+
+// Job is a job the worker is running.
+type Job struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	VideoID   string    `json:"video_id"`
+	UserID    int64     `json:"user_id"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Entry is what a worker publishes about itself.
+type Entry struct {
+	ID          string    `json:"id"`
+	Host        string    `json:"host"`
+	Version     string    `json:"version"`
+	Capacity    int       `json:"capacity"`
+	StartedAt   time.Time `json:"started_at"`
+	HeartbeatAt time.Time `json:"heartbeat_at"`
+	Jobs        []Job     `json:"jobs"`
+}
+
+// Registry keeps this worker's entry in Redis up to date with the jobs it
+// is running.
+type Registry struct {
+	client   *redis.Client
+	interval time.Duration
+
+	mu    sync.Mutex
+	entry Entry
+	jobs  map[string]Job
+}
+
+func NewRegistry(client *redis.Client, version string, capacity int, interval time.Duration) *Registry {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	return &Registry{
+		client:   client,
+		interval: interval,
+		entry: Entry{
+			ID:        uuid.New().String(),
+			Host:      host,
+			Version:   version,
+			Capacity:  capacity,
+			StartedAt: time.Now(),
+		},
+		jobs: make(map[string]Job),
+	}
+}
+
+// Run publishes the entry on every heartbeat until ctx is done, then
+// removes it.
+func (r *Registry) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	logging.Info("Registering worker", "worker_id", r.entry.ID, "host", r.entry.Host, "version", r.entry.Version)
+	r.publish(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.deregister()
+			return
+		case <-ticker.C:
+			r.publish(ctx)
+		}
+	}
+}
+
+// Track lists the job in the entry until the returned func is called.
+func (r *Registry) Track(ctx context.Context, job Job) func() {
+	job.StartedAt = time.Now()
+	key := job.Type + ":" + job.ID
+
+	r.mu.Lock()
+	r.jobs[key] = job
+	r.mu.Unlock()
+	r.publish(ctx)
+
+	return func() {
+		r.mu.Lock()
+		delete(r.jobs, key)
+		r.mu.Unlock()
+		r.publish(context.WithoutCancel(ctx))
+	}
+}
+
+// publish writes the entry with a fresh expiry. Failures are only logged;
+// the next heartbeat tries again.
+func (r *Registry) publish(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry := r.entry
+	entry.HeartbeatAt = time.Now()
+	entry.Jobs = make([]Job, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		entry.Jobs = append(entry.Jobs, job)
+	}
+	sort.Slice(entry.Jobs, func(i, j int) bool { return entry.Jobs[i].StartedAt.Before(entry.Jobs[j].StartedAt) })
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		logging.Error("Failed to encode worker entry", "error", err)
+		return
+	}
+
+	if err := r.client.Set(ctx, r.key(), data, missedBeats*r.interval); err != nil {
+		logging.Warn("Failed to publish worker heartbeat", "worker_id", entry.ID, "error", err)
+	}
+}
+
+func (r *Registry) deregister() {
+	ctx, cancel := context.WithTimeout(context.Background(), r.interval)
+	defer cancel()
+
+	if err := r.client.Del(ctx, r.key()); err != nil {
+		logging.Warn("Failed to deregister worker", "worker_id", r.entry.ID, "error", err)
+	}
+}
+
+func (r *Registry) key() string {
+	return fmt.Sprintf("%s%s", KeyPrefix, r.entry.ID)
+}
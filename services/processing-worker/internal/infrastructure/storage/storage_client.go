@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/video-platform/shared/pkg/httpclient"
+)
+
+type CreateZipRequest struct {
+	VideoID   string   `json:"video_id"`
+	S3Prefix  string   `json:"s3_prefix"`
+	OutputKey string   `json:"output_key"`
+	ExtraKeys []string `json:"extra_keys,omitempty"`
+}
+
+// StorageClient talks to the storage service's internal API.
+type StorageClient interface {
+	CreateZip(ctx context.Context, req CreateZipRequest) error
+}
+
+type storageClient struct {
+	httpClient httpclient.HTTPClient
+	baseURL    string
+}
+
+func NewStorageClient(httpClient httpclient.HTTPClient, baseURL string) StorageClient {
+	return &storageClient{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (c *storageClient) CreateZip(ctx context.Context, req CreateZipRequest) error {
+	if err := c.httpClient.Post(ctx, c.baseURL+"/internal/zip/create", req, nil); err != nil {
+		return fmt.Errorf("storage service create zip failed: %w", err)
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ import "context"
 type CleanupResult struct {
 	VideosDeleted    int
 	S3ObjectsDeleted int
+	UploadsDeleted   int
 }
 
 type CleanupUseCase interface {
 	CleanupExpiredVideos(ctx context.Context) (*CleanupResult, error)
+	CleanupExpiredUploads(ctx context.Context, result *CleanupResult) error
 }
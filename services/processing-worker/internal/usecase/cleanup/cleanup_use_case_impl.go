@@ -61,6 +61,10 @@ func (uc *cleanupUseCaseImpl) cleanupVideo(ctx context.Context, video *entities.
 	s3Objects := uc.collectS3Objects(video)
 
 	if !uc.dryRun {
+		if video.AwaitingUpload && video.MultipartUploadID != nil {
+			uc.abortDirectUpload(ctx, video)
+		}
+
 		for bucket, keys := range s3Objects {
 			if len(keys) == 0 {
 				continue
@@ -95,6 +99,64 @@ func (uc *cleanupUseCaseImpl) cleanupVideo(ctx context.Context, video *entities.
 	return nil
 }
 
+// abortDirectUpload aborts the multipart upload of a direct upload that was
+// never confirmed, so its parts stop being stored. As for resumable uploads,
+// a failed abort is only logged.
+func (uc *cleanupUseCaseImpl) abortDirectUpload(ctx context.Context, video *entities.Video) {
+	uploadsBucket := "video-platform-uploads"
+
+	if err := uc.s3Client.AbortMultipartUpload(ctx, uploadsBucket, video.OriginalPath, *video.MultipartUploadID); err != nil {
+		uc.logger.Warn("Failed to abort multipart upload", "video_id", video.ID, "error", err)
+	}
+}
+
+// CleanupExpiredUploads drops resumable uploads past their expiry, aborting
+// the multipart uploads of those that never completed.
+func (uc *cleanupUseCaseImpl) CleanupExpiredUploads(ctx context.Context, result *CleanupResult) error {
+	var expiredUploads []entities.TusUpload
+	err := uc.db.WithContext(ctx).
+		Where("expires_at < ?", time.Now()).
+		Find(&expiredUploads).Error
+	if err != nil {
+		return fmt.Errorf("failed to query expired uploads: %w", err)
+	}
+
+	uc.logger.Info("Found expired uploads", "count", len(expiredUploads))
+
+	for _, upload := range expiredUploads {
+		if err := uc.cleanupUpload(ctx, &upload, result); err != nil {
+			uc.logger.Error("Failed to cleanup upload", "upload_id", upload.ID, "error", err)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (uc *cleanupUseCaseImpl) cleanupUpload(ctx context.Context, upload *entities.TusUpload, result *CleanupResult) error {
+	if uc.dryRun {
+		uc.logger.Info("DRY RUN: Would delete upload", "upload_id", upload.ID, "completed", upload.VideoID != nil)
+		return nil
+	}
+
+	if upload.VideoID == nil {
+		uploadsBucket := "video-platform-uploads"
+
+		// A failed abort is not retried: the upload may be gone already,
+		// and a bucket lifecycle rule catches the rest.
+		if err := uc.s3Client.AbortMultipartUpload(ctx, uploadsBucket, upload.S3Key, upload.MultipartID); err != nil {
+			uc.logger.Warn("Failed to abort multipart upload", "upload_id", upload.ID, "error", err)
+		}
+	}
+
+	if err := uc.db.WithContext(ctx).Delete(upload).Error; err != nil {
+		return fmt.Errorf("failed to delete upload from database: %w", err)
+	}
+
+	result.UploadsDeleted++
+	return nil
+}
+
 func (uc *cleanupUseCaseImpl) collectS3Objects(video *entities.Video) map[string][]string {
 	objects := make(map[string][]string)
 
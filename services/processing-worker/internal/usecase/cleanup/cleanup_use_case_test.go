@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/video-platform/services/processing-worker/internal/domain/entities"
 	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/storage/s3"
 	"gorm.io/gorm"
 )
 
@@ -53,6 +54,16 @@ func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key str
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
 	args := m.Called(ctx, bucket, prefix)
 	if args.Get(0) == nil {
@@ -61,6 +72,34 @@ func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) (
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
 // Mock DB
 type MockDB struct {
 	Videos []entities.Video
@@ -246,6 +285,63 @@ func TestCleanupUseCase_CleanupVideo_DeleteMultipleError(t *testing.T) {
 	mockS3.AssertExpectations(t)
 }
 
+func TestCleanupUseCase_CleanupVideo_AbortsUnconfirmedDirectUpload(t *testing.T) {
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+	logger := logging.NewLogger("test")
+
+	multipartID := "multipart-1"
+	video := entities.Video{
+		ID:                uuid.New(),
+		OriginalPath:      "uploads/test.mp4",
+		AwaitingUpload:    true,
+		MultipartUploadID: &multipartID,
+	}
+
+	mockS3.On("AbortMultipartUpload", ctx, "video-platform-uploads", "uploads/test.mp4", "multipart-1").Return(nil)
+	// Stop before the database delete, which needs a real DB.
+	mockS3.On("DeleteMultiple", ctx, "video-platform-uploads", []string{"uploads/test.mp4"}).
+		Return(errors.New("s3 error"))
+
+	useCase := &cleanupUseCaseImpl{
+		s3Client: mockS3,
+		logger:   *logger,
+		dryRun:   false,
+	}
+
+	err := useCase.cleanupVideo(ctx, &video, &CleanupResult{})
+
+	assert.Error(t, err)
+	mockS3.AssertExpectations(t)
+}
+
+func TestCleanupUseCase_CleanupVideo_SkipsAbortOnceConfirmed(t *testing.T) {
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+	logger := logging.NewLogger("test")
+
+	multipartID := "multipart-1"
+	video := entities.Video{
+		ID:                uuid.New(),
+		OriginalPath:      "uploads/test.mp4",
+		MultipartUploadID: &multipartID,
+	}
+
+	mockS3.On("DeleteMultiple", ctx, "video-platform-uploads", []string{"uploads/test.mp4"}).
+		Return(errors.New("s3 error"))
+
+	useCase := &cleanupUseCaseImpl{
+		s3Client: mockS3,
+		logger:   *logger,
+		dryRun:   false,
+	}
+
+	err := useCase.cleanupVideo(ctx, &video, &CleanupResult{})
+
+	assert.Error(t, err)
+	mockS3.AssertNotCalled(t, "AbortMultipartUpload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestCleanupUseCase_CleanupVideo_DryRun(t *testing.T) {
 	ctx := context.Background()
 	mockS3 := new(MockS3Client)
@@ -316,3 +412,28 @@ func TestCleanupUseCase_CleanupResult_Accumulation(t *testing.T) {
 	assert.Equal(t, 5, result.VideosDeleted)
 	assert.Equal(t, 15, result.S3ObjectsDeleted)
 }
+
+func TestCleanupUseCase_CleanupUpload_DryRun(t *testing.T) {
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+	logger := logging.NewLogger("test")
+
+	upload := entities.TusUpload{
+		ID:          uuid.New(),
+		S3Key:       "uploads/test.mp4",
+		MultipartID: "multipart-1",
+	}
+
+	useCase := &cleanupUseCaseImpl{
+		s3Client: mockS3,
+		logger:   *logger,
+		dryRun:   true,
+	}
+
+	result := &CleanupResult{}
+	err := useCase.cleanupUpload(ctx, &upload, result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.UploadsDeleted)
+	mockS3.AssertNotCalled(t, "AbortMultipartUpload")
+}
@@ -0,0 +1,11 @@
+package clip
+
+import (
+	"context"
+
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+)
+
+type ClipUseCase interface {
+	Execute(ctx context.Context, cmd commands.ClipCommand) error
+}
@@ -0,0 +1,186 @@
+package clip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+// keyframeTolerance is how close, in seconds, a clip start must be to a
+// keyframe to be cut without re-encoding.
+const keyframeTolerance = 0.01
+
+type clipUseCaseImpl struct {
+	clipRepo        repositories.ClipRepository
+	s3Client        s3.S3Client
+	ffmpegService   ffmpeg.FFmpegService
+	processedBucket string
+	scratchDir      string
+}
+
+func NewClipUseCase(
+	clipRepo repositories.ClipRepository,
+	s3Client s3.S3Client,
+	ffmpegService ffmpeg.FFmpegService,
+	processedBucket string,
+	scratchDir string,
+) ClipUseCase {
+	return &clipUseCaseImpl{
+		clipRepo:        clipRepo,
+		s3Client:        s3Client,
+		ffmpegService:   ffmpegService,
+		processedBucket: processedBucket,
+		scratchDir:      scratchDir,
+	}
+}
+
+// Execute cuts every requested clip from one download of the source. A clip
+// that fails is marked FAILED without affecting the others; only failures
+// that prevent cutting any clip are returned.
+func (uc *clipUseCaseImpl) Execute(ctx context.Context, cmd commands.ClipCommand) error {
+	logging.Info("Starting clip extraction", "video_id", cmd.VideoID, "clips", len(cmd.Clips))
+
+	tmpDir, err := os.MkdirTemp(uc.scratchDir, "video-clips-*")
+	if err != nil {
+		return uc.failAll(ctx, cmd.Clips, fmt.Errorf("failed to create temp dir: %w", err))
+	}
+	defer os.RemoveAll(tmpDir)
+
+	videoPath := filepath.Join(tmpDir, "source"+filepath.Ext(cmd.Filename))
+	if err := uc.downloadSource(ctx, cmd.S3Key, videoPath); err != nil {
+		return uc.failAll(ctx, cmd.Clips, fmt.Errorf("failed to download video: %w", err))
+	}
+
+	probe, err := uc.ffmpegService.Probe(ctx, videoPath)
+	if err != nil {
+		return uc.failAll(ctx, cmd.Clips, fmt.Errorf("failed to probe video: %w", err))
+	}
+
+	keyframes, err := uc.ffmpegService.Keyframes(ctx, videoPath)
+	if err != nil {
+		return uc.failAll(ctx, cmd.Clips, fmt.Errorf("failed to list keyframes: %w", err))
+	}
+
+	for _, clip := range cmd.Clips {
+		if err := uc.clipRepo.MarkAsStarted(ctx, clip.ClipID); err != nil {
+			return fmt.Errorf("failed to mark clip as started: %w", err)
+		}
+
+		if err := uc.cutClip(ctx, cmd, clip, videoPath, tmpDir, probe, keyframes); err != nil {
+			uc.fail(ctx, clip.ClipID, err)
+		}
+	}
+
+	logging.Info("Clip extraction completed", "video_id", cmd.VideoID)
+	return nil
+}
+
+func (uc *clipUseCaseImpl) cutClip(
+	ctx context.Context,
+	cmd commands.ClipCommand,
+	clip commands.ClipRange,
+	videoPath, workDir string,
+	probe *ffmpeg.ProbeResult,
+	keyframes []float64,
+) error {
+	start, end := clip.Start, clip.End
+	if probe.Duration > 0 {
+		if start >= probe.Duration {
+			return errors.New("clip starts after the end of the video")
+		}
+		end = math.Min(end, probe.Duration)
+	}
+
+	mode := entities.ClipModeReencode
+	ext := ".mp4"
+	if onKeyframe(start+probe.StartTime, keyframes) {
+		mode = entities.ClipModeCopy
+		ext = strings.ToLower(filepath.Ext(cmd.Filename))
+	}
+
+	outputPath := filepath.Join(workDir, clip.ClipID.String()+ext)
+	if err := uc.ffmpegService.CutClip(ctx, videoPath, outputPath, start, end, mode == entities.ClipModeCopy); err != nil {
+		return fmt.Errorf("failed to cut clip: %w", err)
+	}
+
+	info, err := os.Stat(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to read clip: %w", err)
+	}
+
+	s3Key := fmt.Sprintf("processed/%s/clips/%s%s", cmd.VideoID, clip.ClipID, ext)
+	if err := uc.uploadFile(ctx, outputPath, s3Key); err != nil {
+		return fmt.Errorf("failed to upload clip: %w", err)
+	}
+
+	if err := uc.clipRepo.UpdateClipComplete(ctx, clip.ClipID, mode, s3Key, info.Size()); err != nil {
+		return fmt.Errorf("failed to update completion: %w", err)
+	}
+
+	logging.Info("Clip completed", "clip_id", clip.ClipID, "mode", mode)
+	return nil
+}
+
+// onKeyframe reports whether t is within keyframeTolerance of a keyframe.
+// keyframes must be sorted.
+func onKeyframe(t float64, keyframes []float64) bool {
+	i := sort.SearchFloat64s(keyframes, t-keyframeTolerance)
+	return i < len(keyframes) && keyframes[i] <= t+keyframeTolerance
+}
+
+func (uc *clipUseCaseImpl) downloadSource(ctx context.Context, s3Key, path string) error {
+	reader, err := uc.s3Client.GetObject(ctx, "", s3Key)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+	return err
+}
+
+func (uc *clipUseCaseImpl) uploadFile(ctx context.Context, path, s3Key string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
+	}
+	defer file.Close()
+
+	return uc.s3Client.Upload(ctx, uc.processedBucket, s3Key, file)
+}
+
+func (uc *clipUseCaseImpl) fail(ctx context.Context, clipID uuid.UUID, err error) {
+	logging.Error("Clip failed", "clip_id", clipID, "error", err)
+
+	errMsg := err.Error()
+	if updateErr := uc.clipRepo.UpdateStatus(ctx, clipID, entities.StatusFailed, &errMsg); updateErr != nil {
+		logging.Error("Failed to update clip error status", "error", updateErr)
+	}
+}
+
+func (uc *clipUseCaseImpl) failAll(ctx context.Context, clips []commands.ClipRange, err error) error {
+	for _, clip := range clips {
+		uc.fail(ctx, clip.ClipID, err)
+	}
+	return err
+}
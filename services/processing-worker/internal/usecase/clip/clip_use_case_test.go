@@ -0,0 +1,302 @@
+package clip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockClipRepository struct {
+	mock.Mock
+}
+
+func (m *MockClipRepository) MarkAsStarted(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockClipRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus, errorMsg *string) error {
+	args := m.Called(ctx, id, status, errorMsg)
+	return args.Error(0)
+}
+
+func (m *MockClipRepository) UpdateClipComplete(ctx context.Context, id uuid.UUID, mode entities.ClipMode, outputPath string, sizeBytes int64) error {
+	args := m.Called(ctx, id, mode, outputPath, sizeBytes)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+// Mock FFmpegService
+type MockFFmpegService struct {
+	mock.Mock
+}
+
+func (m *MockFFmpegService) ExtractFrames(ctx context.Context, videoPath, outputDir string, opts ffmpeg.ExtractOptions) (int, error) {
+	args := m.Called(ctx, videoPath, outputDir, opts)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockFFmpegService) GeneratePreview(ctx context.Context, videoPath, outputPath string, opts ffmpeg.PreviewOptions) error {
+	args := m.Called(ctx, videoPath, outputPath, opts)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) ExtractAudio(ctx context.Context, videoPath, outputPath, format string) error {
+	args := m.Called(ctx, videoPath, outputPath, format)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) ExtractSubtitle(ctx context.Context, videoPath, outputPath string, streamIndex int, format string) error {
+	args := m.Called(ctx, videoPath, outputPath, streamIndex, format)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) Probe(ctx context.Context, videoPath string) (*ffmpeg.ProbeResult, error) {
+	args := m.Called(ctx, videoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ffmpeg.ProbeResult), args.Error(1)
+}
+
+func (m *MockFFmpegService) DetectScenes(ctx context.Context, videoPath string, threshold float64) ([]float64, error) {
+	args := m.Called(ctx, videoPath, threshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]float64), args.Error(1)
+}
+
+func (m *MockFFmpegService) EncodeImageSequence(ctx context.Context, inputPattern, outputPath string, opts ffmpeg.EncodeOptions) error {
+	args := m.Called(ctx, inputPattern, outputPath, opts)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) Keyframes(ctx context.Context, videoPath string) ([]float64, error) {
+	args := m.Called(ctx, videoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]float64), args.Error(1)
+}
+
+func (m *MockFFmpegService) CutClip(ctx context.Context, videoPath, outputPath string, start, end float64, streamCopy bool) error {
+	args := m.Called(ctx, videoPath, outputPath, start, end, streamCopy)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) TranscodeHLS(ctx context.Context, videoPath, outputDir string, rendition ffmpeg.HLSRendition) error {
+	args := m.Called(ctx, videoPath, outputDir, rendition)
+	return args.Error(0)
+}
+
+// writeClip stands in for ffmpeg by writing a five byte clip.
+func writeClip(args mock.Arguments) {
+	os.WriteFile(args.String(2), []byte("video"), 0644)
+}
+
+func TestClipUseCase_Execute_CopiesOnKeyframesAndReencodesOtherwise(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockClipRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+
+	videoID := uuid.New()
+	aligned := commands.ClipRange{ClipID: uuid.New(), Start: 10, End: 15}
+	unaligned := commands.ClipRange{ClipID: uuid.New(), Start: 12.5, End: 90}
+
+	cmd := commands.ClipCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/" + videoID.String() + "/talk.MKV",
+		Filename: "talk.MKV",
+		Clips:    []commands.ClipRange{aligned, unaligned},
+	}
+
+	alignedKey := "processed/" + videoID.String() + "/clips/" + aligned.ClipID.String() + ".mkv"
+	unalignedKey := "processed/" + videoID.String() + "/clips/" + unaligned.ClipID.String() + ".mp4"
+
+	mockS3.On("GetObject", ctx, "", cmd.S3Key).Return(io.NopCloser(strings.NewReader("source")), nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(&ffmpeg.ProbeResult{Duration: 60}, nil)
+	mockFFmpeg.On("Keyframes", ctx, mock.AnythingOfType("string")).Return([]float64{0, 5.005, 10.004, 15}, nil)
+	mockRepo.On("MarkAsStarted", ctx, aligned.ClipID).Return(nil)
+	mockRepo.On("MarkAsStarted", ctx, unaligned.ClipID).Return(nil)
+	mockFFmpeg.On("CutClip", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 10.0, 15.0, true).Run(writeClip).Return(nil)
+	mockFFmpeg.On("CutClip", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 12.5, 60.0, false).Run(writeClip).Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", alignedKey, mock.Anything).Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", unalignedKey, mock.Anything).Return(nil)
+	mockRepo.On("UpdateClipComplete", ctx, aligned.ClipID, entities.ClipModeCopy, alignedKey, int64(5)).Return(nil)
+	mockRepo.On("UpdateClipComplete", ctx, unaligned.ClipID, entities.ClipModeReencode, unalignedKey, int64(5)).Return(nil)
+
+	useCase := NewClipUseCase(mockRepo, mockS3, mockFFmpeg, "processed-bucket", "")
+
+	// Act
+	err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+	mockFFmpeg.AssertExpectations(t)
+}
+
+func TestClipUseCase_Execute_FailsClipPastTheEnd(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockClipRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+
+	videoID := uuid.New()
+	late := commands.ClipRange{ClipID: uuid.New(), Start: 75, End: 80}
+	cmd := commands.ClipCommand{
+		VideoID:  videoID,
+		S3Key:    "uploads/" + videoID.String() + "/talk.mp4",
+		Filename: "talk.mp4",
+		Clips:    []commands.ClipRange{late},
+	}
+
+	mockS3.On("GetObject", ctx, "", cmd.S3Key).Return(io.NopCloser(strings.NewReader("source")), nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(&ffmpeg.ProbeResult{Duration: 60}, nil)
+	mockFFmpeg.On("Keyframes", ctx, mock.AnythingOfType("string")).Return([]float64{0}, nil)
+	mockRepo.On("MarkAsStarted", ctx, late.ClipID).Return(nil)
+	mockRepo.On("UpdateStatus", ctx, late.ClipID, entities.StatusFailed, mock.MatchedBy(func(msg *string) bool {
+		return *msg == "clip starts after the end of the video"
+	})).Return(nil)
+
+	useCase := NewClipUseCase(mockRepo, mockS3, mockFFmpeg, "processed-bucket", "")
+
+	// Act
+	err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockFFmpeg.AssertNotCalled(t, "CutClip", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestClipUseCase_Execute_DownloadErrorFailsAllClips(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockClipRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+
+	videoID := uuid.New()
+	clips := []commands.ClipRange{{ClipID: uuid.New(), End: 5}, {ClipID: uuid.New(), Start: 5, End: 10}}
+	cmd := commands.ClipCommand{
+		VideoID:  videoID,
+		S3Key:    "uploads/" + videoID.String() + "/talk.mp4",
+		Filename: "talk.mp4",
+		Clips:    clips,
+	}
+
+	mockS3.On("GetObject", ctx, "", cmd.S3Key).Return(nil, errors.New("NoSuchKey"))
+	mockRepo.On("UpdateStatus", ctx, clips[0].ClipID, entities.StatusFailed, mock.Anything).Return(nil)
+	mockRepo.On("UpdateStatus", ctx, clips[1].ClipID, entities.StatusFailed, mock.Anything).Return(nil)
+
+	useCase := NewClipUseCase(mockRepo, mockS3, mockFFmpeg, "processed-bucket", "")
+
+	// Act
+	err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to download video")
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,19 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ClipRange is one requested clip, in seconds from the start of the video.
+type ClipRange struct {
+	ClipID uuid.UUID
+	Start  float64
+	End    float64
+}
+
+// ClipCommand cuts clips out of an uploaded source video.
+type ClipCommand struct {
+	VideoID  uuid.UUID
+	UserID   int64
+	S3Key    string
+	Filename string
+	Clips    []ClipRange
+}
@@ -7,4 +7,6 @@ type ProcessCommand struct {
 	UserID   int64
 	S3Key    string
 	Filename string
+	FileSize int64
+	Options  ProcessingOptions
 }
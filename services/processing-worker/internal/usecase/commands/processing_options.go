@@ -0,0 +1,242 @@
+package commands
+
+const (
+	defaultContactSheetColumns = 5
+	defaultContactSheetRows    = 6
+	defaultThumbnailWidth      = 320
+	defaultThumbnailHeight     = 180
+	defaultPreviewFormat       = "gif"
+	defaultPreviewDuration     = 4
+	defaultPreviewWidth        = 320
+	defaultDedupeMaxDistance   = 5
+	defaultMinLuminance        = 16
+	defaultMinVariance         = 50
+	defaultMinSharpness        = 50
+	defaultShotThreshold       = 0.4
+	defaultActivityThreshold   = 1
+	defaultActivityPadding     = 1
+	defaultDominantColors      = 5
+	defaultWatermarkPosition   = "bottom-right"
+	defaultWatermarkOpacity    = 0.5
+	defaultWatermarkFontSize   = 24
+)
+
+var defaultHLSRenditions = []int{360, 720, 1080}
+
+type ProcessingOptions struct {
+	Mosaic    MosaicOptions    `json:"mosaic"`
+	Preview   PreviewOptions   `json:"preview"`
+	Audio     AudioOptions     `json:"audio"`
+	Subtitles SubtitleOptions  `json:"subtitles"`
+	Dedupe    DedupeOptions    `json:"dedupe"`
+	Quality   QualityOptions   `json:"quality"`
+	Shots     ShotOptions      `json:"shots"`
+	Activity  ActivityOptions  `json:"activity"`
+	Colors    ColorOptions     `json:"colors"`
+	HLS       HLSOptions       `json:"hls"`
+	Watermark WatermarkOptions `json:"watermark"`
+	Video     VideoOptions     `json:"video"`
+}
+
+type MosaicOptions struct {
+	Columns     int   `json:"columns"`
+	Rows        int   `json:"rows"`
+	ThumbWidth  int   `json:"thumb_width"`
+	ThumbHeight int   `json:"thumb_height"`
+	Captions    *bool `json:"captions"`
+}
+
+func (o MosaicOptions) WithDefaults() MosaicOptions {
+	if o.Columns <= 0 {
+		o.Columns = defaultContactSheetColumns
+	}
+	if o.Rows <= 0 {
+		o.Rows = defaultContactSheetRows
+	}
+	if o.ThumbWidth <= 0 {
+		o.ThumbWidth = defaultThumbnailWidth
+	}
+	if o.ThumbHeight <= 0 {
+		o.ThumbHeight = defaultThumbnailHeight
+	}
+	if o.Captions == nil {
+		captions := true
+		o.Captions = &captions
+	}
+	return o
+}
+
+type PreviewOptions struct {
+	Format   string `json:"format"`
+	Duration int    `json:"duration"`
+	Width    int    `json:"width"`
+}
+
+func (o PreviewOptions) WithDefaults() PreviewOptions {
+	if o.Format == "" {
+		o.Format = defaultPreviewFormat
+	}
+	if o.Duration <= 0 {
+		o.Duration = defaultPreviewDuration
+	}
+	if o.Width <= 0 {
+		o.Width = defaultPreviewWidth
+	}
+	return o
+}
+
+// AudioOptions requests the audio stream as an extra artifact. An empty
+// Format disables audio extraction.
+type AudioOptions struct {
+	Format       string `json:"format"`
+	IncludeInZip bool   `json:"include_in_zip"`
+}
+
+type SubtitleOptions struct {
+	IncludeInZip bool `json:"include_in_zip"`
+}
+
+// DedupeOptions drops frames whose perceptual hash is within MaxDistance bits
+// of the previously kept frame.
+type DedupeOptions struct {
+	Enabled     bool `json:"enabled"`
+	MaxDistance *int `json:"max_distance"`
+}
+
+func (o DedupeOptions) WithDefaults() DedupeOptions {
+	if o.MaxDistance == nil {
+		distance := defaultDedupeMaxDistance
+		o.MaxDistance = &distance
+	}
+	return o
+}
+
+// QualityOptions drops black, blank and blurry frames whose scores fall
+// below the thresholds.
+type QualityOptions struct {
+	Enabled      bool     `json:"enabled"`
+	MinLuminance *float64 `json:"min_luminance"`
+	MinVariance  *float64 `json:"min_variance"`
+	MinSharpness *float64 `json:"min_sharpness"`
+}
+
+func (o QualityOptions) WithDefaults() QualityOptions {
+	if o.MinLuminance == nil {
+		v := float64(defaultMinLuminance)
+		o.MinLuminance = &v
+	}
+	if o.MinVariance == nil {
+		v := float64(defaultMinVariance)
+		o.MinVariance = &v
+	}
+	if o.MinSharpness == nil {
+		v := float64(defaultMinSharpness)
+		o.MinSharpness = &v
+	}
+	return o
+}
+
+// ShotOptions enables shot boundary detection. Threshold is the ffmpeg scene
+// change score, from 0 to 1, above which a frame starts a new shot.
+type ShotOptions struct {
+	Enabled   bool     `json:"enabled"`
+	Threshold *float64 `json:"threshold"`
+}
+
+func (o ShotOptions) WithDefaults() ShotOptions {
+	if o.Threshold == nil {
+		threshold := defaultShotThreshold
+		o.Threshold = &threshold
+	}
+	return o
+}
+
+// ActivityOptions enables the per-second activity timeline. With
+// KeepActiveOnly only frames within Padding seconds of a second whose score,
+// the percentage of the picture that changed, reaches Threshold are kept.
+type ActivityOptions struct {
+	Enabled        bool `json:"enabled"`
+	KeepActiveOnly bool `json:"keep_active_only"`
+	Threshold      *int `json:"threshold"`
+	Padding        *int `json:"padding"`
+}
+
+func (o ActivityOptions) WithDefaults() ActivityOptions {
+	if o.Threshold == nil {
+		threshold := defaultActivityThreshold
+		o.Threshold = &threshold
+	}
+	if o.Padding == nil {
+		padding := defaultActivityPadding
+		o.Padding = &padding
+	}
+	return o
+}
+
+// ColorOptions enables per-frame color histograms and the Count dominant
+// colors used by the color search.
+type ColorOptions struct {
+	Enabled bool `json:"enabled"`
+	Count   int  `json:"count"`
+}
+
+func (o ColorOptions) WithDefaults() ColorOptions {
+	if o.Count <= 0 {
+		o.Count = defaultDominantColors
+	}
+	return o
+}
+
+// HLSOptions enables the adaptive streaming ladder. Renditions lists the
+// target heights; rungs taller than the source are skipped.
+type HLSOptions struct {
+	Enabled    bool  `json:"enabled"`
+	Renditions []int `json:"renditions"`
+}
+
+func (o HLSOptions) WithDefaults() HLSOptions {
+	if len(o.Renditions) == 0 {
+		o.Renditions = defaultHLSRenditions
+	}
+	return o
+}
+
+// WatermarkOptions draws an overlay on every extracted frame: the user's
+// stored watermark image AssetID, or a Text template that may reference
+// {filename}, {timestamp} and {frame}.
+type WatermarkOptions struct {
+	AssetID  string   `json:"asset_id"`
+	Text     string   `json:"text"`
+	Position string   `json:"position"`
+	Opacity  *float64 `json:"opacity"`
+	FontSize int      `json:"font_size"`
+}
+
+func (o WatermarkOptions) Enabled() bool {
+	return o.AssetID != "" || o.Text != ""
+}
+
+func (o WatermarkOptions) WithDefaults() WatermarkOptions {
+	if o.Position == "" {
+		o.Position = defaultWatermarkPosition
+	}
+	if o.Opacity == nil {
+		opacity := defaultWatermarkOpacity
+		o.Opacity = &opacity
+	}
+	if o.FontSize <= 0 {
+		o.FontSize = defaultWatermarkFontSize
+	}
+	return o
+}
+
+// VideoOptions picks the video stream by its index in the container and
+// corrects its picture. A nil Rotation follows the rotation metadata, any
+// other value replaces it; Deinterlace is auto, off, yadif or bwdif and
+// ToneMap is auto, off or on.
+type VideoOptions struct {
+	StreamIndex *int   `json:"stream_index"`
+	Rotation    *int   `json:"rotation"`
+	Deinterlace string `json:"deinterlace"`
+	ToneMap     string `json:"tone_map"`
+}
@@ -0,0 +1,17 @@
+package commands
+
+import "github.com/google/uuid"
+
+// RenderCommand assembles frames of a processed video into a new video.
+// Frames lists frame indexes in playback order; when empty every uploaded
+// frame is used.
+type RenderCommand struct {
+	RenderID uuid.UUID
+	VideoID  uuid.UUID
+	UserID   int64
+	Frames   []int
+	Format   string
+	FPS      float64
+	Width    int
+	Height   int
+}
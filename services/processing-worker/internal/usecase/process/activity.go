@@ -0,0 +1,68 @@
+package process
+
+import (
+	"image"
+
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/imaging"
+)
+
+// analyzeActivity scores every frame by the percentage of the picture that
+// changed since the previous frame and returns the scores as a time series
+// with one byte per extracted frame. The first frame has nothing to compare
+// against and scores 0. Scores are also set on the frames for filtering and
+// the manifest.
+func analyzeActivity(frames []frame) ([]byte, error) {
+	scores := make([]byte, len(frames))
+
+	var previous *image.Gray
+	for i := range frames {
+		img, err := imaging.DecodeFile(frames[i].Path)
+		if err != nil {
+			return nil, err
+		}
+
+		thumb := imaging.ActivityThumbnail(img)
+		if previous != nil {
+			scores[i] = byte(imaging.ChangedPercent(previous, thumb))
+		}
+		score := int(scores[i])
+		frames[i].Activity = &score
+		previous = thumb
+	}
+
+	return scores, nil
+}
+
+// restoreActivity sets the scores stored by an earlier attempt on the
+// frames its filters kept. Scores are indexed by frame number, since the
+// kept frames alone would compare frames that were never adjacent.
+func restoreActivity(frames []frame, scores []byte) {
+	for i := range frames {
+		if n := frames[i].Index - 1; n >= 0 && n < len(scores) {
+			score := int(scores[n])
+			frames[i].Activity = &score
+		}
+	}
+}
+
+// activeFrames keeps the frames within padding frames of any frame whose
+// activity score reaches threshold, so each active segment keeps a little
+// context before and after the motion.
+func activeFrames(frames []frame, threshold, padding int) (kept, dropped []frame) {
+	for i, f := range frames {
+		active := false
+		for j := max(0, i-padding); j <= min(len(frames)-1, i+padding); j++ {
+			if frames[j].Activity != nil && *frames[j].Activity >= threshold {
+				active = true
+				break
+			}
+		}
+
+		if active {
+			kept = append(kept, f)
+		} else {
+			dropped = append(dropped, f)
+		}
+	}
+	return kept, dropped
+}
@@ -0,0 +1,39 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+// extractAudio writes the first audio stream in the requested format and
+// uploads it under the processed prefix, returning its S3 key. Nothing is
+// produced when audio was not requested or the source has no audio stream.
+func (uc *processUseCaseImpl) extractAudio(ctx context.Context, videoID uuid.UUID, videoPath, workDir string, probe *ffmpeg.ProbeResult, opts commands.AudioOptions) (*string, error) {
+	if opts.Format == "" {
+		return nil, nil
+	}
+
+	if !probe.HasAudio() {
+		logging.Info("Source has no audio stream, skipping audio extraction", "video_id", videoID)
+		return nil, nil
+	}
+
+	filename := "audio." + opts.Format
+	audioPath := filepath.Join(workDir, filename)
+	if err := uc.ffmpegService.ExtractAudio(ctx, videoPath, audioPath, opts.Format); err != nil {
+		return nil, err
+	}
+
+	s3Key := fmt.Sprintf("processed/%s/audio/%s", videoID, filename)
+	if err := uc.uploadFile(ctx, audioPath, s3Key); err != nil {
+		return nil, err
+	}
+
+	return &s3Key, nil
+}
@@ -0,0 +1,327 @@
+package process
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+// segmentDuration is how many seconds of the source one extraction segment
+// covers, so a retried job redoes at most one segment of extraction.
+const segmentDuration = 300
+
+// deleteBatchSize is the most keys a single S3 delete request accepts.
+const deleteBatchSize = 1000
+
+// Stages recorded in the checkpoint once they complete.
+const (
+	stageFilter   = "filter"
+	stageManifest = "manifest"
+	stageMosaics  = "mosaics"
+	stagePreview  = "preview"
+	stageShots    = "shots"
+	stageHLS      = "hls"
+)
+
+// segment is a stretch of the source that is extracted and uploaded as a
+// unit. A zero Duration covers the whole source.
+type segment struct {
+	Index      int
+	Start      float64
+	Duration   float64
+	FirstFrame int
+}
+
+// planSegments splits the source into segments of segmentDuration. A source
+// that fits in one segment, or whose duration is unknown, is extracted in a
+// single pass.
+func planSegments(duration float64, fps int) []segment {
+	if duration <= segmentDuration {
+		return []segment{{}}
+	}
+
+	var segments []segment
+	for i := 0; float64(i*segmentDuration) < duration; i++ {
+		segments = append(segments, segment{
+			Index:      i,
+			Start:      float64(i * segmentDuration),
+			Duration:   segmentDuration,
+			FirstFrame: i*segmentDuration*fps + 1,
+		})
+	}
+	return segments
+}
+
+// contains reports whether the frame number belongs to the segment. The fps
+// filter can emit one frame past the end of a segment, which the next
+// segment extracts again.
+func (s segment) contains(index, fps int) bool {
+	if s.Duration == 0 {
+		return true
+	}
+	return index >= s.FirstFrame && index < s.FirstFrame+int(s.Duration)*fps
+}
+
+func segmentStage(index int) string {
+	return fmt.Sprintf("extract:%d", index)
+}
+
+func framesPrefix(videoID uuid.UUID) string {
+	return fmt.Sprintf("processed/%s/frames/", videoID)
+}
+
+// extractFrames fills framesDir with the frames of the source. Segments an
+// earlier attempt uploaded are downloaded again once their objects check
+// out; the rest are extracted, uploaded and checkpointed one at a time. It
+// returns the frame count and whether any segment was extracted by this
+// attempt, in which case stages recorded by earlier attempts are stale.
+func (uc *processUseCaseImpl) extractFrames(ctx context.Context, videoID uuid.UUID, checkpoint *entities.Checkpoint, videoPath, tmpDir, framesDir string, probe *ffmpeg.ProbeResult, opts ffmpeg.ExtractOptions) (int, bool, error) {
+	segments := planSegments(probe.Duration, opts.FPS)
+	prefix := framesPrefix(videoID)
+
+	restored, err := uc.restoreFrames(ctx, checkpoint, segments, prefix, framesDir)
+	if err != nil {
+		return 0, false, err
+	}
+
+	frameCount := 0
+	extracted := false
+	for _, seg := range segments {
+		if count, ok := restored[seg.Index]; ok {
+			frameCount += count
+			continue
+		}
+
+		count, err := uc.extractSegment(ctx, videoID, videoPath, tmpDir, framesDir, prefix, seg, opts)
+		if err != nil {
+			return 0, false, err
+		}
+		frameCount += count
+		extracted = true
+	}
+
+	return frameCount, extracted, nil
+}
+
+// extractSegment extracts one segment into its own directory, uploads its
+// frames, moves them into framesDir and checkpoints them.
+func (uc *processUseCaseImpl) extractSegment(ctx context.Context, videoID uuid.UUID, videoPath, tmpDir, framesDir, prefix string, seg segment, opts ffmpeg.ExtractOptions) (int, error) {
+	segmentDir := filepath.Join(tmpDir, fmt.Sprintf("segment-%d", seg.Index))
+	if err := os.MkdirAll(segmentDir, 0755); err != nil {
+		return 0, fmt.Errorf("failed to create segment dir: %w", err)
+	}
+	defer os.RemoveAll(segmentDir)
+
+	opts.Start, opts.Duration, opts.StartNumber = seg.Start, seg.Duration, seg.FirstFrame
+	count, err := uc.ffmpegService.ExtractFrames(ctx, videoPath, segmentDir, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract frames: %w", err)
+	}
+
+	frames, err := listFrames(segmentDir, opts.FPS)
+	if err != nil {
+		return 0, err
+	}
+
+	records := make([]*entities.CheckpointFrame, 0, len(frames))
+	for _, f := range frames {
+		if !seg.contains(f.Index, opts.FPS) {
+			count--
+			continue
+		}
+
+		size, etag, err := fileDigest(f.Path)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := uc.uploadFile(ctx, f.Path, prefix+f.Filename); err != nil {
+			return 0, fmt.Errorf("failed to upload frames: %w", err)
+		}
+
+		if err := os.Rename(f.Path, filepath.Join(framesDir, f.Filename)); err != nil {
+			return 0, fmt.Errorf("failed to move frame: %w", err)
+		}
+
+		records = append(records, &entities.CheckpointFrame{
+			VideoID:    videoID,
+			FrameIndex: f.Index,
+			Segment:    seg.Index,
+			Filename:   f.Filename,
+			Size:       size,
+			ETag:       etag,
+		})
+	}
+
+	if err := uc.checkpointRepo.SaveFrames(ctx, records); err != nil {
+		return 0, fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	if err := uc.completeStage(ctx, videoID, segmentStage(seg.Index)); err != nil {
+		return 0, err
+	}
+
+	logging.Info("Extracted frames", "video_id", videoID, "segment", seg.Index, "count", count)
+	return count, nil
+}
+
+// restoreFrames downloads the frames of every checkpointed segment whose
+// objects still match the recorded size and ETag, and returns the frame
+// count per restored segment. Objects under the prefix that no restored
+// segment accounts for are deleted, since they were left by a segment that
+// did not finish or by frames a filter dropped.
+func (uc *processUseCaseImpl) restoreFrames(ctx context.Context, checkpoint *entities.Checkpoint, segments []segment, prefix, framesDir string) (map[int]int, error) {
+	if len(checkpoint.Frames) == 0 && len(checkpoint.Stages) == 0 {
+		return nil, nil
+	}
+
+	objects, err := uc.s3Client.ListObjectDetails(ctx, uc.processedBucket, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checkpointed frames: %w", err)
+	}
+
+	stored := make(map[string]s3.ObjectInfo, len(objects))
+	for _, object := range objects {
+		stored[object.Key] = object
+	}
+
+	bySegment := make(map[int][]*entities.CheckpointFrame)
+	for _, f := range checkpoint.Frames {
+		bySegment[f.Segment] = append(bySegment[f.Segment], f)
+	}
+
+	restored := make(map[int]int)
+	reused := make(map[string]bool)
+	for _, seg := range segments {
+		if !checkpoint.HasStage(segmentStage(seg.Index)) {
+			continue
+		}
+
+		frames := bySegment[seg.Index]
+		if !framesStored(frames, stored, prefix) {
+			logging.Warn("Checkpointed frames do not match storage, extracting segment again", "segment", seg.Index)
+			continue
+		}
+
+		for _, f := range frames {
+			if err := uc.downloadFile(ctx, prefix+f.Filename, filepath.Join(framesDir, f.Filename)); err != nil {
+				return nil, fmt.Errorf("failed to restore frames: %w", err)
+			}
+			reused[prefix+f.Filename] = true
+		}
+		restored[seg.Index] = len(frames)
+	}
+
+	var stale []string
+	for _, object := range objects {
+		if !reused[object.Key] {
+			stale = append(stale, object.Key)
+		}
+	}
+	if err := uc.deleteObjects(ctx, stale); err != nil {
+		return nil, fmt.Errorf("failed to delete stale frames: %w", err)
+	}
+
+	logging.Info("Restored frames from checkpoint", "segments", len(restored), "stale", len(stale))
+	return restored, nil
+}
+
+// framesStored reports whether every frame is in storage with the size and
+// ETag it was uploaded with.
+func framesStored(frames []*entities.CheckpointFrame, stored map[string]s3.ObjectInfo, prefix string) bool {
+	for _, f := range frames {
+		object, ok := stored[prefix+f.Filename]
+		if !ok || object.Size != f.Size || object.ETag != f.ETag {
+			return false
+		}
+	}
+	return true
+}
+
+// dropFrames removes the frames a filter dropped from the checkpoint and
+// from storage.
+func (uc *processUseCaseImpl) dropFrames(ctx context.Context, videoID uuid.UUID, all, kept []frame) error {
+	keep := make(map[int]bool, len(kept))
+	for _, f := range kept {
+		keep[f.Index] = true
+	}
+
+	var indexes []int
+	var keys []string
+	for _, f := range all {
+		if !keep[f.Index] {
+			indexes = append(indexes, f.Index)
+			keys = append(keys, framesPrefix(videoID)+f.Filename)
+		}
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	if err := uc.checkpointRepo.DeleteFrames(ctx, videoID, indexes); err != nil {
+		return fmt.Errorf("failed to update checkpoint: %w", err)
+	}
+	return uc.deleteObjects(ctx, keys)
+}
+
+func (uc *processUseCaseImpl) deleteObjects(ctx context.Context, keys []string) error {
+	for start := 0; start < len(keys); start += deleteBatchSize {
+		if err := uc.s3Client.DeleteMultiple(ctx, uc.processedBucket, keys[start:min(start+deleteBatchSize, len(keys))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// completeStage records that a stage finished so a retry can skip it.
+func (uc *processUseCaseImpl) completeStage(ctx context.Context, videoID uuid.UUID, stage string) error {
+	if err := uc.checkpointRepo.SaveStage(ctx, videoID, stage); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	return nil
+}
+
+func (uc *processUseCaseImpl) downloadFile(ctx context.Context, s3Key, path string) error {
+	reader, err := uc.s3Client.GetObject(ctx, uc.processedBucket, s3Key)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", filepath.Base(path), err)
+	}
+	defer reader.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Base(path), err)
+	}
+	defer file.Close()
+
+	if _, err := file.ReadFrom(reader); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// fileDigest returns the size and MD5 of a file, which is the ETag S3
+// assigns to an object uploaded in a single part.
+func fileDigest(path string) (int64, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,39 @@
+package process
+
+import (
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/imaging"
+)
+
+// analyzeColors computes the histogram and dominant colors of every frame,
+// setting them on the frames for the manifest and returning the dominant
+// colors as rows for the color search.
+func analyzeColors(videoID uuid.UUID, frames []frame, k int) ([]*entities.FrameColor, error) {
+	rows := make([]*entities.FrameColor, 0, len(frames)*k)
+
+	for i := range frames {
+		img, err := imaging.DecodeFile(frames[i].Path)
+		if err != nil {
+			return nil, err
+		}
+
+		profile := imaging.Colors(img, k)
+		frames[i].Colors = &profile
+
+		for rank, c := range profile.Dominant {
+			rows = append(rows, &entities.FrameColor{
+				VideoID:    videoID,
+				FrameIndex: frames[i].Index,
+				Rank:       rank + 1,
+				Hex:        c.Hex,
+				R:          int(c.R),
+				G:          int(c.G),
+				B:          int(c.B),
+				Weight:     c.Weight,
+			})
+		}
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,126 @@
+package process
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/imaging"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+)
+
+// filterFrames runs the optional frame filters over the extracted frames.
+// Dropped frames are deleted from disk; their uploaded copies are left to
+// the caller. Stats are nil when no filter is enabled.
+func filterFrames(frames []frame, opts commands.ProcessingOptions) ([]frame, *entities.FrameFilterStats, error) {
+	keepActiveOnly := opts.Activity.Enabled && opts.Activity.KeepActiveOnly
+	if !opts.Dedupe.Enabled && !opts.Quality.Enabled && !keepActiveOnly {
+		return frames, nil, nil
+	}
+
+	stats := &entities.FrameFilterStats{}
+
+	// Activity scores come from the full sequence, so inactive frames are
+	// dropped before the other filters remove any of their neighbours.
+	if keepActiveOnly {
+		activity := opts.Activity.WithDefaults()
+		kept, dropped := activeFrames(frames, *activity.Threshold, *activity.Padding)
+		for _, f := range dropped {
+			if err := os.Remove(f.Path); err != nil {
+				return nil, nil, fmt.Errorf("failed to remove inactive frame: %w", err)
+			}
+		}
+		stats.InactiveFrames = len(dropped)
+		frames = kept
+	}
+
+	// Quality runs first so a dropped black frame between two identical
+	// shots does not stop the second one from being deduplicated.
+	if opts.Quality.Enabled {
+		kept, err := qualityFilterFrames(frames, opts.Quality.WithDefaults())
+		if err != nil {
+			return nil, nil, err
+		}
+		stats.LowQualityFrames = len(frames) - len(kept)
+		frames = kept
+	}
+
+	if opts.Dedupe.Enabled {
+		kept, err := dedupeFrames(frames, *opts.Dedupe.WithDefaults().MaxDistance)
+		if err != nil {
+			return nil, nil, err
+		}
+		stats.DuplicateFrames = len(frames) - len(kept)
+		frames = kept
+	}
+
+	return frames, stats, nil
+}
+
+// qualityFilterFrames scores every frame and drops those below any of the
+// thresholds. Kept frames carry their scores into the manifest.
+func qualityFilterFrames(frames []frame, opts commands.QualityOptions) ([]frame, error) {
+	kept := make([]frame, 0, len(frames))
+
+	for _, f := range frames {
+		img, err := imaging.DecodeFile(f.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		scores := imaging.Quality(img)
+		if scores.Luminance < *opts.MinLuminance || scores.Variance < *opts.MinVariance || scores.Sharpness < *opts.MinSharpness {
+			if err := os.Remove(f.Path); err != nil {
+				return nil, fmt.Errorf("failed to remove low quality frame: %w", err)
+			}
+			continue
+		}
+
+		f.Quality = &scores
+		kept = append(kept, f)
+	}
+
+	return kept, nil
+}
+
+// scoreQuality sets the quality scores on frames that were filtered by an
+// earlier attempt.
+func scoreQuality(frames []frame) error {
+	for i := range frames {
+		img, err := imaging.DecodeFile(frames[i].Path)
+		if err != nil {
+			return err
+		}
+
+		scores := imaging.Quality(img)
+		frames[i].Quality = &scores
+	}
+	return nil
+}
+
+// dedupeFrames keeps a frame only when its dHash differs from the previously
+// kept frame by more than maxDistance bits.
+func dedupeFrames(frames []frame, maxDistance int) ([]frame, error) {
+	kept := make([]frame, 0, len(frames))
+
+	var lastHash uint64
+	for i, f := range frames {
+		img, err := imaging.DecodeFile(f.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		hash := imaging.DHash(img)
+		if i > 0 && imaging.HammingDistance(hash, lastHash) <= maxDistance {
+			if err := os.Remove(f.Path); err != nil {
+				return nil, fmt.Errorf("failed to remove duplicate frame: %w", err)
+			}
+			continue
+		}
+
+		kept = append(kept, f)
+		lastHash = hash
+	}
+
+	return kept, nil
+}
@@ -0,0 +1,68 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/imaging"
+)
+
+// frame is an extracted frame on local disk together with its position in
+// the source video.
+type frame struct {
+	Index    int
+	Filename string
+	Path     string
+	PTS      float64
+	Quality  *imaging.QualityScores
+	Activity *int
+	Colors   *imaging.ColorProfile
+}
+
+// listFrames returns the frames in framesDir ordered by frame number. The
+// presentation timestamp is derived from the frame number because the fps
+// filter emits frame N at (N-1)/fps seconds.
+func listFrames(framesDir string, fps int) ([]frame, error) {
+	files, err := os.ReadDir(framesDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read frames dir: %w", err)
+	}
+
+	frames := make([]frame, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		index, ok := parseFrameNumber(file.Name())
+		if !ok {
+			continue
+		}
+
+		frames = append(frames, frame{
+			Index:    index,
+			Filename: file.Name(),
+			Path:     filepath.Join(framesDir, file.Name()),
+			PTS:      float64(index-1) / float64(fps),
+		})
+	}
+
+	sort.Slice(frames, func(i, j int) bool { return frames[i].Index < frames[j].Index })
+	return frames, nil
+}
+
+func parseFrameNumber(name string) (int, bool) {
+	if !strings.HasPrefix(name, "frame_") {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "frame_"), filepath.Ext(name)))
+	if err != nil || number < 1 {
+		return 0, false
+	}
+	return number, true
+}
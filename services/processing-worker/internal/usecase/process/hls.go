@@ -0,0 +1,135 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+const hlsAudioBitrate = 128
+
+// hlsVideoBitrates are the target video bitrates, in kbit/s, of the heights
+// a ladder may request.
+var hlsVideoBitrates = map[int]int{
+	240:  400,
+	360:  800,
+	480:  1400,
+	720:  2800,
+	1080: 5000,
+}
+
+type hlsRendition struct {
+	Name string
+	ffmpeg.HLSRendition
+}
+
+// generateHLS encodes the streaming ladder, uploads every rendition under
+// processed/<id>/hls/ and writes the master playlist last, returning its S3
+// key.
+func (uc *processUseCaseImpl) generateHLS(ctx context.Context, videoID uuid.UUID, videoPath, workDir string, probe *ffmpeg.ProbeResult, pipeline *ffmpeg.VideoPipeline, opts commands.HLSOptions) (string, error) {
+	ladder, err := hlsLadder(probe, pipeline, opts.WithDefaults().Renditions)
+	if err != nil {
+		return "", err
+	}
+
+	s3Prefix := fmt.Sprintf("processed/%s/hls/", videoID)
+	for _, rendition := range ladder {
+		outputDir := filepath.Join(workDir, "hls", rendition.Name)
+		if err := os.MkdirAll(outputDir, 0755); err != nil {
+			return "", fmt.Errorf("failed to create %s dir: %w", rendition.Name, err)
+		}
+
+		logging.Info("Transcoding HLS rendition", "video_id", videoID, "rendition", rendition.Name)
+		if err := uc.ffmpegService.TranscodeHLS(ctx, videoPath, outputDir, rendition.HLSRendition); err != nil {
+			return "", fmt.Errorf("failed to transcode %s: %w", rendition.Name, err)
+		}
+
+		if err := uc.uploadFrames(ctx, outputDir, s3Prefix+rendition.Name+"/"); err != nil {
+			return "", err
+		}
+	}
+
+	masterPath := filepath.Join(workDir, "hls", "master.m3u8")
+	if err := os.WriteFile(masterPath, []byte(masterPlaylist(ladder)), 0644); err != nil {
+		return "", fmt.Errorf("failed to write master playlist: %w", err)
+	}
+
+	s3Key := s3Prefix + "master.m3u8"
+	if err := uc.uploadFile(ctx, masterPath, s3Key); err != nil {
+		return "", err
+	}
+
+	return s3Key, nil
+}
+
+// hlsLadder picks the requested rungs that fit the pipeline's stream as
+// displayed, lowest first, keeping its aspect ratio. A source shorter than
+// every rung gets a single rendition at its own height.
+func hlsLadder(probe *ffmpeg.ProbeResult, pipeline *ffmpeg.VideoPipeline, heights []int) ([]hlsRendition, error) {
+	stream := probe.Stream(pipeline.StreamIndex)
+	if stream == nil || stream.Width <= 0 || stream.Height <= 0 {
+		return nil, ffmpeg.ErrNoVideoStream
+	}
+	width, height := pipeline.DisplaySize(stream)
+
+	audioBitrate := 0
+	if probe.HasAudio() {
+		audioBitrate = hlsAudioBitrate
+	}
+
+	sorted := append([]int(nil), heights...)
+	sort.Ints(sorted)
+
+	var ladder []hlsRendition
+	for _, rung := range sorted {
+		bitrate, ok := hlsVideoBitrates[rung]
+		if !ok || rung > height {
+			continue
+		}
+		if len(ladder) > 0 && ladder[len(ladder)-1].Height == rung {
+			continue
+		}
+		ladder = append(ladder, newHLSRendition(pipeline, width, height, rung, bitrate, audioBitrate))
+	}
+
+	if len(ladder) == 0 {
+		ladder = append(ladder, newHLSRendition(pipeline, width, height, height&^1, hlsVideoBitrates[240], audioBitrate))
+	}
+
+	return ladder, nil
+}
+
+func newHLSRendition(pipeline *ffmpeg.VideoPipeline, sourceWidth, sourceHeight, height, videoBitrate, audioBitrate int) hlsRendition {
+	width := (sourceWidth*height/sourceHeight + 1) &^ 1
+	return hlsRendition{
+		Name: fmt.Sprintf("%dp", height),
+		HLSRendition: ffmpeg.HLSRendition{
+			Width:        width,
+			Height:       height,
+			VideoBitrate: videoBitrate,
+			AudioBitrate: audioBitrate,
+			Video:        pipeline,
+		},
+	}
+}
+
+// masterPlaylist lists every rendition's media playlist by its relative
+// path so the gateway can rewrite the URIs when serving it.
+func masterPlaylist(ladder []hlsRendition) string {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	for _, rendition := range ladder {
+		bandwidth := (rendition.VideoBitrate + rendition.AudioBitrate) * 1000
+		fmt.Fprintf(&b, "#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d\n", bandwidth, rendition.Width, rendition.Height)
+		fmt.Fprintf(&b, "%s/index.m3u8\n", rendition.Name)
+	}
+	return b.String()
+}
@@ -0,0 +1,176 @@
+package process
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/imaging"
+)
+
+type manifest struct {
+	VideoID         string          `json:"video_id"`
+	ExtractionFPS   int             `json:"extraction_fps"`
+	SourceFrameRate float64         `json:"source_frame_rate"`
+	StartTime       float64         `json:"start_time"`
+	Frames          []manifestEntry `json:"frames"`
+}
+
+type manifestEntry struct {
+	Index    int     `json:"index"`
+	Filename string  `json:"filename"`
+	PTS      float64 `json:"pts"`
+	Timecode string  `json:"timecode"`
+	Size     int64   `json:"size"`
+	Checksum string  `json:"checksum"`
+
+	Quality  *imaging.QualityScores `json:"quality,omitempty"`
+	Activity *int                   `json:"activity,omitempty"`
+	Colors   *imaging.ColorProfile  `json:"colors,omitempty"`
+}
+
+// generateManifest writes manifest.json and manifest.csv describing every
+// extracted frame and uploads them next to the frames. The storage service
+// places them at the root of the archive.
+func (uc *processUseCaseImpl) generateManifest(ctx context.Context, videoID uuid.UUID, frames []frame, fps int, probe *ffmpeg.ProbeResult, workDir string) error {
+	if len(frames) == 0 {
+		return nil
+	}
+
+	m, err := buildManifest(videoID, frames, fps, probe)
+	if err != nil {
+		return err
+	}
+
+	jsonPath := filepath.Join(workDir, "manifest.json")
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := os.WriteFile(jsonPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	csvPath := filepath.Join(workDir, "manifest.csv")
+	if err := writeManifestCSV(csvPath, m); err != nil {
+		return err
+	}
+
+	if err := uc.uploadFile(ctx, jsonPath, fmt.Sprintf("processed/%s/manifest.json", videoID)); err != nil {
+		return err
+	}
+	return uc.uploadFile(ctx, csvPath, fmt.Sprintf("processed/%s/manifest.csv", videoID))
+}
+
+func buildManifest(videoID uuid.UUID, frames []frame, fps int, probe *ffmpeg.ProbeResult) (*manifest, error) {
+	m := &manifest{
+		VideoID:       videoID.String(),
+		ExtractionFPS: fps,
+		StartTime:     probe.StartTime,
+		Frames:        make([]manifestEntry, len(frames)),
+	}
+	if stream := probe.VideoStream(); stream != nil {
+		m.SourceFrameRate = stream.FrameRate
+	}
+
+	// Timecodes are non-drop-frame at the nominal source rate; without a
+	// known source rate the extraction rate is used instead.
+	timecodeRate := int(math.Round(m.SourceFrameRate))
+	if timecodeRate <= 0 {
+		timecodeRate = fps
+	}
+
+	for i, f := range frames {
+		size, checksum, err := fileChecksum(f.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		// Both are on the source timeline, which starts at StartTime.
+		pts := math.Round((probe.StartTime+f.PTS)*1000) / 1000
+		m.Frames[i] = manifestEntry{
+			Index:    f.Index,
+			Filename: f.Filename,
+			PTS:      pts,
+			Timecode: formatTimecode(pts, timecodeRate),
+			Size:     size,
+			Checksum: checksum,
+			Quality:  f.Quality,
+			Activity: f.Activity,
+			Colors:   f.Colors,
+		}
+	}
+
+	return m, nil
+}
+
+func writeManifestCSV(path string, m *manifest) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create manifest csv: %w", err)
+	}
+
+	w := csv.NewWriter(file)
+	w.Write([]string{"index", "filename", "pts", "timecode", "size", "checksum", "luminance", "variance", "sharpness", "activity"})
+	for _, e := range m.Frames {
+		record := []string{
+			strconv.Itoa(e.Index),
+			e.Filename,
+			strconv.FormatFloat(e.PTS, 'f', 3, 64),
+			e.Timecode,
+			strconv.FormatInt(e.Size, 10),
+			e.Checksum,
+			"", "", "", "",
+		}
+		if e.Quality != nil {
+			record[6] = strconv.FormatFloat(e.Quality.Luminance, 'f', 2, 64)
+			record[7] = strconv.FormatFloat(e.Quality.Variance, 'f', 2, 64)
+			record[8] = strconv.FormatFloat(e.Quality.Sharpness, 'f', 2, 64)
+		}
+		if e.Activity != nil {
+			record[9] = strconv.Itoa(*e.Activity)
+		}
+		w.Write(record)
+	}
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write manifest csv: %w", err)
+	}
+	return file.Close()
+}
+
+func fileChecksum(path string) (int64, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to hash %s: %w", filepath.Base(path), err)
+	}
+	return size, "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// formatTimecode renders seconds as an SMPTE HH:MM:SS:FF timecode, using the
+// frame whose display interval contains the given time.
+func formatTimecode(seconds float64, rate int) string {
+	total := int64(math.Floor(seconds*float64(rate) + 1e-6))
+	frames := total % int64(rate)
+	secs := total / int64(rate)
+	return fmt.Sprintf("%02d:%02d:%02d:%02d", secs/3600, (secs/60)%60, secs%60, frames)
+}
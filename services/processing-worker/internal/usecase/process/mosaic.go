@@ -0,0 +1,155 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/imaging"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+const (
+	spriteColumns     = 10
+	spriteRows        = 10
+	spriteThumbWidth  = 160
+	spriteThumbHeight = 90
+	mosaicJPEGQuality = 80
+)
+
+// generateMosaics renders the contact sheet and the scrubbing sprite sheets
+// (with their WebVTT thumbnails track) and uploads them next to the frames.
+func (uc *processUseCaseImpl) generateMosaics(ctx context.Context, videoID uuid.UUID, frames []frame, fps int, workDir string, opts commands.MosaicOptions) error {
+	if len(frames) == 0 {
+		return nil
+	}
+
+	opts = opts.WithDefaults()
+
+	mosaicDir := filepath.Join(workDir, "mosaics")
+	if err := os.MkdirAll(mosaicDir, 0755); err != nil {
+		return fmt.Errorf("failed to create mosaic dir: %w", err)
+	}
+
+	contactSheetPath := filepath.Join(mosaicDir, "contact_sheet.jpg")
+	if err := renderContactSheet(contactSheetPath, frames, opts); err != nil {
+		return err
+	}
+
+	if err := uc.uploadFile(ctx, contactSheetPath, fmt.Sprintf("processed/%s/contact-sheets/contact_001.jpg", videoID)); err != nil {
+		return err
+	}
+
+	spritePrefix := fmt.Sprintf("processed/%s/sprites/", videoID)
+	spriteNames := make([]string, 0, len(frames)/(spriteColumns*spriteRows)+1)
+	for start := 0; start < len(frames); start += spriteColumns * spriteRows {
+		end := min(start+spriteColumns*spriteRows, len(frames))
+		name := fmt.Sprintf("sprite_%03d.jpg", len(spriteNames)+1)
+		spritePath := filepath.Join(mosaicDir, name)
+
+		if err := renderSprite(spritePath, frames[start:end]); err != nil {
+			return err
+		}
+		if err := uc.uploadFile(ctx, spritePath, spritePrefix+name); err != nil {
+			return err
+		}
+		spriteNames = append(spriteNames, name)
+	}
+
+	vttPath := filepath.Join(mosaicDir, "thumbnails.vtt")
+	if err := os.WriteFile(vttPath, []byte(buildThumbnailsVTT(frames, fps, spriteNames)), 0644); err != nil {
+		return fmt.Errorf("failed to write thumbnails track: %w", err)
+	}
+
+	if err := uc.uploadFile(ctx, vttPath, spritePrefix+"thumbnails.vtt"); err != nil {
+		return err
+	}
+
+	logging.Info("Generated mosaics", "video_id", videoID, "sprites", len(spriteNames))
+	return nil
+}
+
+// renderContactSheet samples frames evenly across the whole video so a
+// single grid summarises it regardless of its length.
+func renderContactSheet(path string, frames []frame, opts commands.MosaicOptions) error {
+	slots := opts.Columns * opts.Rows
+	if len(frames) < slots {
+		slots = len(frames)
+	}
+
+	cells := make([]imaging.Cell, slots)
+	for i := range cells {
+		f := frames[i*len(frames)/slots]
+
+		img, err := imaging.DecodeFile(f.Path)
+		if err != nil {
+			return err
+		}
+
+		cells[i] = imaging.Cell{Image: img}
+		if *opts.Captions {
+			cells[i].Caption = formatClock(f.PTS)
+		}
+	}
+
+	rows := (slots + opts.Columns - 1) / opts.Columns
+	sheet := imaging.Grid(cells, opts.Columns, rows, opts.ThumbWidth, opts.ThumbHeight)
+	return imaging.EncodeJPEGFile(path, sheet, mosaicJPEGQuality)
+}
+
+func renderSprite(path string, frames []frame) error {
+	cells := make([]imaging.Cell, len(frames))
+	for i, f := range frames {
+		img, err := imaging.DecodeFile(f.Path)
+		if err != nil {
+			return err
+		}
+		cells[i] = imaging.Cell{Image: img}
+	}
+
+	rows := (len(frames) + spriteColumns - 1) / spriteColumns
+	sprite := imaging.Grid(cells, spriteColumns, rows, spriteThumbWidth, spriteThumbHeight)
+	return imaging.EncodeJPEGFile(path, sprite, mosaicJPEGQuality)
+}
+
+// buildThumbnailsVTT maps each frame's display interval to its tile in the
+// sprite sheets using media fragment (#xywh) references.
+func buildThumbnailsVTT(frames []frame, fps int, spriteNames []string) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+
+	perSprite := spriteColumns * spriteRows
+	for i, f := range frames {
+		end := f.PTS + 1/float64(fps)
+		if i+1 < len(frames) {
+			end = frames[i+1].PTS
+		}
+
+		slot := i % perSprite
+		fmt.Fprintf(&b, "\n%s --> %s\n%s#xywh=%d,%d,%d,%d\n",
+			formatVTTTimestamp(f.PTS),
+			formatVTTTimestamp(end),
+			spriteNames[i/perSprite],
+			(slot%spriteColumns)*spriteThumbWidth,
+			(slot/spriteColumns)*spriteThumbHeight,
+			spriteThumbWidth,
+			spriteThumbHeight,
+		)
+	}
+
+	return b.String()
+}
+
+func formatClock(seconds float64) string {
+	total := int(seconds)
+	return fmt.Sprintf("%02d:%02d:%02d", total/3600, (total/60)%60, total%60)
+}
+
+func formatVTTTimestamp(seconds float64) string {
+	millis := int64(seconds*1000 + 0.5)
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", millis/3600000, (millis/60000)%60, (millis/1000)%60, millis%1000)
+}
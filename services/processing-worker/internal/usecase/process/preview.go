@@ -0,0 +1,36 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+)
+
+// generatePreview renders the looping list-view preview and uploads it next
+// to the frames, returning its S3 key.
+func (uc *processUseCaseImpl) generatePreview(ctx context.Context, videoID uuid.UUID, videoPath, workDir string, opts commands.PreviewOptions) (string, error) {
+	opts = opts.WithDefaults()
+
+	filename := "preview." + opts.Format
+	previewPath := filepath.Join(workDir, filename)
+
+	err := uc.ffmpegService.GeneratePreview(ctx, videoPath, previewPath, ffmpeg.PreviewOptions{
+		Format:   opts.Format,
+		Duration: float64(opts.Duration),
+		Width:    opts.Width,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s3Key := fmt.Sprintf("processed/%s/%s", videoID, filename)
+	if err := uc.uploadFile(ctx, previewPath, s3Key); err != nil {
+		return "", err
+	}
+
+	return s3Key, nil
+}
@@ -2,10 +2,30 @@ package process
 
 import (
 	"context"
+	"time"
 
 	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
 )
 
+// SourceLimits bound the sources the worker accepts. A zero duration or
+// dimension disables that limit and an empty codec list accepts any codec.
+type SourceLimits struct {
+	MaxDuration   time.Duration
+	MaxDimension  int
+	AllowedCodecs []string
+}
+
+// JobLimits bound what one job may take from the node. An empty ScratchDir
+// uses the system temp dir and MinFreeSpace is kept free on top of what a
+// job is expected to write. The deadline is TimeoutBase plus TimeoutFactor
+// times the source duration; a zero TimeoutBase disables it.
+type JobLimits struct {
+	ScratchDir    string
+	MinFreeSpace  uint64
+	TimeoutBase   time.Duration
+	TimeoutFactor float64
+}
+
 type ProcessUseCase interface {
 	Execute(ctx context.Context, cmd commands.ProcessCommand) error
 }
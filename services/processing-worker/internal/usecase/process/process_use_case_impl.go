@@ -2,6 +2,7 @@ package process
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,33 +11,57 @@ import (
 	"github.com/video-platform/services/processing-worker/internal/domain/entities"
 	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
 	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/storage"
 	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
 	"github.com/video-platform/shared/pkg/logging"
 	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
 	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
+const extractionFPS = 1
+
 type processUseCaseImpl struct {
 	videoRepo       repositories.VideoRepository
+	subtitleRepo    repositories.SubtitleTrackRepository
+	shotRepo        repositories.ShotRepository
+	colorRepo       repositories.FrameColorRepository
+	checkpointRepo  repositories.CheckpointRepository
 	s3Client        s3.S3Client
 	ffmpegService   ffmpeg.FFmpegService
+	storageClient   storage.StorageClient
 	publisher       rabbitmq.Publisher
 	processedBucket string
+	sourceLimits    SourceLimits
+	jobLimits       JobLimits
 }
 
 func NewProcessUseCase(
 	videoRepo repositories.VideoRepository,
+	subtitleRepo repositories.SubtitleTrackRepository,
+	shotRepo repositories.ShotRepository,
+	colorRepo repositories.FrameColorRepository,
+	checkpointRepo repositories.CheckpointRepository,
 	s3Client s3.S3Client,
 	ffmpegService ffmpeg.FFmpegService,
+	storageClient storage.StorageClient,
 	publisher rabbitmq.Publisher,
 	processedBucket string,
+	sourceLimits SourceLimits,
+	jobLimits JobLimits,
 ) ProcessUseCase {
 	return &processUseCaseImpl{
 		videoRepo:       videoRepo,
+		subtitleRepo:    subtitleRepo,
+		shotRepo:        shotRepo,
+		colorRepo:       colorRepo,
+		checkpointRepo:  checkpointRepo,
 		s3Client:        s3Client,
 		ffmpegService:   ffmpegService,
+		storageClient:   storageClient,
 		publisher:       publisher,
 		processedBucket: processedBucket,
+		sourceLimits:    sourceLimits,
+		jobLimits:       jobLimits,
 	}
 }
 
@@ -51,12 +76,16 @@ func (uc *processUseCaseImpl) Execute(ctx context.Context, cmd commands.ProcessC
 		return fmt.Errorf("failed to update status: %w", err)
 	}
 
-	tmpDir, err := os.MkdirTemp("", "video-processing-*")
+	tmpDir, err := os.MkdirTemp(uc.jobLimits.ScratchDir, "video-processing-*")
 	if err != nil {
 		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to create temp dir: %w", err))
 	}
 	defer os.RemoveAll(tmpDir)
 
+	if err := uc.jobLimits.ensureScratchSpace(tmpDir, uint64(cmd.FileSize)); err != nil {
+		return uc.handleError(ctx, cmd.VideoID, err)
+	}
+
 	videoPath := filepath.Join(tmpDir, cmd.Filename)
 	framesDir := filepath.Join(tmpDir, "frames")
 	if err := os.MkdirAll(framesDir, 0755); err != nil {
@@ -82,26 +111,257 @@ func (uc *processUseCaseImpl) Execute(ctx context.Context, cmd commands.ProcessC
 	}
 	videoFile.Close()
 
-	logging.Info("Extracting frames with FFmpeg", "video_id", cmd.VideoID)
-	frameCount, err := uc.ffmpegService.ExtractFrames(ctx, videoPath, framesDir, 1)
+	probeCtx, cancelProbe := uc.jobLimits.withTimeout(ctx, 0)
+	probe, err := uc.ffmpegService.Probe(probeCtx, videoPath)
+	cancelProbe()
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, &sourceError{
+			code:    entities.ErrorCodeInvalidVideo,
+			message: fmt.Sprintf("failed to probe video: %v", err),
+		})
+	}
+
+	pipeline, err := ffmpeg.ResolveVideoPipeline(probe, ffmpeg.VideoSettings{
+		StreamIndex: cmd.Options.Video.StreamIndex,
+		Rotation:    cmd.Options.Video.Rotation,
+		Deinterlace: cmd.Options.Video.Deinterlace,
+		ToneMap:     cmd.Options.Video.ToneMap,
+	})
 	if err != nil {
-		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to extract frames: %w", err))
+		if errors.Is(err, ffmpeg.ErrNoVideoStream) {
+			err = &sourceError{code: entities.ErrorCodeNoVideoStream, message: err.Error()}
+		}
+		return uc.handleError(ctx, cmd.VideoID, err)
 	}
 
-	logging.Info("Extracted frames", "count", frameCount)
+	if err := validateSource(probe, pipeline.StreamIndex, uc.sourceLimits); err != nil {
+		return uc.handleError(ctx, cmd.VideoID, err)
+	}
 
-	logging.Info("Uploading frames to S3", "video_id", cmd.VideoID)
-	s3Prefix := fmt.Sprintf("processed/%s/frames/", cmd.VideoID)
-	if err := uc.uploadFrames(ctx, framesDir, s3Prefix); err != nil {
-		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to upload frames: %w", err))
+	// The duration only feeds completion estimates, so failing to store it
+	// does not fail the job.
+	if probe.Duration > 0 {
+		if err := uc.videoRepo.UpdateSourceDuration(ctx, cmd.VideoID, probe.Duration); err != nil {
+			logging.Warn("Failed to store source duration", "video_id", cmd.VideoID, "error", err)
+		}
+	}
+
+	if err := uc.jobLimits.ensureScratchSpace(tmpDir, estimateOutput(probe, pipeline, cmd.Options)); err != nil {
+		return uc.handleError(ctx, cmd.VideoID, err)
+	}
+
+	ctx, cancel := uc.jobLimits.withTimeout(ctx, probe.Duration)
+	defer cancel()
+
+	overlay, err := uc.prepareOverlay(ctx, cmd, tmpDir)
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, err)
+	}
+
+	checkpoint, err := uc.checkpointRepo.FindByVideoID(ctx, cmd.VideoID)
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to load checkpoint: %w", err))
+	}
+
+	logging.Info("Extracting frames with FFmpeg", "video_id", cmd.VideoID, "stream", pipeline.StreamIndex, "rotation", pipeline.Rotation, "deinterlace", pipeline.Deinterlace, "tone_map", pipeline.ToneMap)
+	frameCount, extracted, err := uc.extractFrames(ctx, cmd.VideoID, checkpoint, videoPath, tmpDir, framesDir, probe, ffmpeg.ExtractOptions{
+		FPS:     extractionFPS,
+		Video:   pipeline,
+		Overlay: overlay,
+	})
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, err)
+	}
+
+	// Stages derived from the frames are redone once any frame is new.
+	done := func(stage string) bool {
+		return !extracted && checkpoint.HasStage(stage)
+	}
+
+	frames, err := listFrames(framesDir, extractionFPS)
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, err)
+	}
+
+	if done(stageFilter) {
+		// The checkpoint only holds the frames the filters kept, but the
+		// manifest still wants their quality and activity scores.
+		if cmd.Options.Quality.Enabled {
+			if err := scoreQuality(frames); err != nil {
+				return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to score frames: %w", err))
+			}
+		}
+
+		if cmd.Options.Activity.Enabled {
+			video, err := uc.videoRepo.FindByID(ctx, cmd.VideoID)
+			if err != nil {
+				return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to load activity scores: %w", err))
+			}
+			restoreActivity(frames, video.ActivityScores)
+		}
+	} else {
+		if cmd.Options.Activity.Enabled {
+			scores, err := analyzeActivity(frames)
+			if err != nil {
+				return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to analyze activity: %w", err))
+			}
+
+			if err := uc.videoRepo.UpdateActivityScores(ctx, cmd.VideoID, scores); err != nil {
+				return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to update activity scores: %w", err))
+			}
+		}
+
+		kept, filterStats, err := filterFrames(frames, cmd.Options)
+		if err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to filter frames: %w", err))
+		}
+
+		if filterStats != nil {
+			frameCount = len(kept)
+			logging.Info("Filtered frames", "kept", frameCount, "duplicates", filterStats.DuplicateFrames, "low_quality", filterStats.LowQualityFrames, "inactive", filterStats.InactiveFrames)
+
+			if err := uc.videoRepo.UpdateFrameFilterStats(ctx, cmd.VideoID, *filterStats); err != nil {
+				return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to update frame filter stats: %w", err))
+			}
+
+			if err := uc.dropFrames(ctx, cmd.VideoID, frames, kept); err != nil {
+				return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to delete filtered frames: %w", err))
+			}
+		}
+		frames = kept
+
+		if err := uc.completeStage(ctx, cmd.VideoID, stageFilter); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, err)
+		}
+	}
+
+	if cmd.Options.Colors.Enabled {
+		colors, err := analyzeColors(cmd.VideoID, frames, cmd.Options.Colors.WithDefaults().Count)
+		if err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to analyze colors: %w", err))
+		}
+
+		if err := uc.colorRepo.ReplaceByVideoID(ctx, cmd.VideoID, colors); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to save frame colors: %w", err))
+		}
+	}
+
+	if !done(stageManifest) {
+		if err := uc.generateManifest(ctx, cmd.VideoID, frames, extractionFPS, probe, tmpDir); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to generate manifest: %w", err))
+		}
+
+		if err := uc.completeStage(ctx, cmd.VideoID, stageManifest); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, err)
+		}
+	}
+
+	if !done(stageMosaics) {
+		logging.Info("Generating contact sheet and sprites", "video_id", cmd.VideoID)
+		if err := uc.generateMosaics(ctx, cmd.VideoID, frames, extractionFPS, tmpDir, cmd.Options.Mosaic); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to generate mosaics: %w", err))
+		}
+
+		if err := uc.completeStage(ctx, cmd.VideoID, stageMosaics); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, err)
+		}
+	}
+
+	// The preview and HLS renditions come from the source, not the frames.
+	if !checkpoint.HasStage(stagePreview) {
+		logging.Info("Generating preview", "video_id", cmd.VideoID)
+		previewKey, err := uc.generatePreview(ctx, cmd.VideoID, videoPath, tmpDir, cmd.Options.Preview)
+		if err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to generate preview: %w", err))
+		}
+
+		if err := uc.videoRepo.UpdatePreviewPath(ctx, cmd.VideoID, previewKey); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to update preview path: %w", err))
+		}
+
+		if err := uc.completeStage(ctx, cmd.VideoID, stagePreview); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, err)
+		}
+	}
+
+	audioKey, err := uc.extractAudio(ctx, cmd.VideoID, videoPath, tmpDir, probe, cmd.Options.Audio)
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to extract audio: %w", err))
+	}
+
+	if err := uc.videoRepo.UpdateAudioInfo(ctx, cmd.VideoID, probe.HasAudio(), audioKey); err != nil {
+		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to update audio info: %w", err))
+	}
+
+	subtitleTracks, err := uc.extractSubtitles(ctx, cmd.VideoID, videoPath, tmpDir, probe)
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to extract subtitles: %w", err))
+	}
+
+	if len(subtitleTracks) > 0 {
+		if err := uc.subtitleRepo.ReplaceByVideoID(ctx, cmd.VideoID, subtitleTracks); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to save subtitle tracks: %w", err))
+		}
+	}
+
+	if cmd.Options.Shots.Enabled && !done(stageShots) {
+		logging.Info("Detecting shots", "video_id", cmd.VideoID)
+		if err := uc.detectShots(ctx, cmd.VideoID, videoPath, frames, probe, cmd.Options.Shots); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to detect shots: %w", err))
+		}
+
+		if err := uc.completeStage(ctx, cmd.VideoID, stageShots); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, err)
+		}
+	}
+
+	if cmd.Options.HLS.Enabled && !checkpoint.HasStage(stageHLS) {
+		logging.Info("Generating HLS renditions", "video_id", cmd.VideoID)
+		hlsKey, err := uc.generateHLS(ctx, cmd.VideoID, videoPath, tmpDir, probe, pipeline, cmd.Options.HLS)
+		if err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to generate HLS: %w", err))
+		}
+
+		if err := uc.videoRepo.UpdateHLSPath(ctx, cmd.VideoID, hlsKey); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to update HLS path: %w", err))
+		}
+
+		if err := uc.completeStage(ctx, cmd.VideoID, stageHLS); err != nil {
+			return uc.handleError(ctx, cmd.VideoID, err)
+		}
+	}
+
+	var extraKeys []string
+	if audioKey != nil && cmd.Options.Audio.IncludeInZip {
+		extraKeys = append(extraKeys, *audioKey)
+	}
+	if cmd.Options.Subtitles.IncludeInZip {
+		for _, track := range subtitleTracks {
+			extraKeys = append(extraKeys, track.SRTPath, track.VTTPath)
+		}
 	}
 
 	zipPath := fmt.Sprintf("processed/%s/%s.zip", cmd.VideoID, cmd.Filename)
 
+	logging.Info("Requesting ZIP from storage service", "video_id", cmd.VideoID)
+	err = uc.storageClient.CreateZip(ctx, storage.CreateZipRequest{
+		VideoID:   cmd.VideoID.String(),
+		S3Prefix:  framesPrefix(cmd.VideoID),
+		OutputKey: zipPath,
+		ExtraKeys: extraKeys,
+	})
+	if err != nil {
+		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to create zip: %w", err))
+	}
+
 	if err := uc.videoRepo.UpdateProcessingComplete(ctx, cmd.VideoID, frameCount, zipPath); err != nil {
 		return uc.handleError(ctx, cmd.VideoID, fmt.Errorf("failed to update completion: %w", err))
 	}
 
+	if err := uc.checkpointRepo.DeleteByVideoID(ctx, cmd.VideoID); err != nil {
+		logging.Error("Failed to delete checkpoint", "video_id", cmd.VideoID, "error", err)
+	}
+
 	notificationMsg := map[string]interface{}{
 		"video_id":    cmd.VideoID.String(),
 		"user_id":     cmd.UserID,
@@ -128,24 +388,38 @@ func (uc *processUseCaseImpl) uploadFrames(ctx context.Context, framesDir, s3Pre
 			continue
 		}
 
-		framePath := filepath.Join(framesDir, file.Name())
-		frameFile, err := os.Open(framePath)
-		if err != nil {
-			return fmt.Errorf("failed to open frame %s: %w", file.Name(), err)
+		if err := uc.uploadFile(ctx, filepath.Join(framesDir, file.Name()), s3Prefix+file.Name()); err != nil {
+			return err
 		}
+	}
 
-		s3Key := s3Prefix + file.Name()
-		if err := uc.s3Client.Upload(ctx, uc.processedBucket, s3Key, frameFile); err != nil {
-			frameFile.Close()
-			return fmt.Errorf("failed to upload frame %s: %w", file.Name(), err)
-		}
-		frameFile.Close()
+	return nil
+}
+
+func (uc *processUseCaseImpl) uploadFile(ctx context.Context, path, s3Key string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
 	}
+	defer file.Close()
 
+	if err := uc.s3Client.Upload(ctx, uc.processedBucket, s3Key, file); err != nil {
+		return fmt.Errorf("failed to upload %s: %w", filepath.Base(path), err)
+	}
 	return nil
 }
 
+// handleError records the failure and notifies the user. It returns nil for a
+// rejected source or a job past its deadline, so the job is acked rather
+// than requeued.
 func (uc *processUseCaseImpl) handleError(ctx context.Context, videoID uuid.UUID, err error) error {
+	timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+	if timedOut {
+		err = fmt.Errorf("processing timed out: %w", err)
+		// The job's deadline has passed; record the failure regardless.
+		ctx = context.WithoutCancel(ctx)
+	}
+
 	logging.Error("Video processing failed", "video_id", videoID, "error", err)
 
 	errMsg := err.Error()
@@ -159,9 +433,22 @@ func (uc *processUseCaseImpl) handleError(ctx context.Context, videoID uuid.UUID
 		"error_message": errMsg,
 	}
 
+	var rejected *sourceError
+	if errors.As(err, &rejected) {
+		if updateErr := uc.videoRepo.UpdateErrorCode(ctx, videoID, rejected.code); updateErr != nil {
+			logging.Error("Failed to update error code", "error", updateErr)
+		}
+		notificationMsg["error_code"] = rejected.code
+	}
+
 	if pubErr := uc.publisher.Publish(ctx, "video.notification.queue", notificationMsg); pubErr != nil {
 		logging.Error("Failed to publish error notification", "error", pubErr)
 	}
 
+	if rejected != nil || timedOut {
+		// Redelivering the job would only reject the source or run out of
+		// time again.
+		return nil
+	}
 	return err
 }
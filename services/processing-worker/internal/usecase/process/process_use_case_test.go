@@ -2,8 +2,16 @@ package process
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -12,7 +20,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/imaging"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/storage"
 	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/messaging/rabbitmq"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
 // Mock VideoRepository
@@ -43,6 +56,121 @@ func (m *MockVideoRepository) UpdateProcessingComplete(ctx context.Context, id u
 	return args.Error(0)
 }
 
+func (m *MockVideoRepository) UpdatePreviewPath(ctx context.Context, id uuid.UUID, previewPath string) error {
+	args := m.Called(ctx, id, previewPath)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateHLSPath(ctx context.Context, id uuid.UUID, hlsPath string) error {
+	args := m.Called(ctx, id, hlsPath)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateErrorCode(ctx context.Context, id uuid.UUID, errorCode string) error {
+	args := m.Called(ctx, id, errorCode)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateFrameFilterStats(ctx context.Context, id uuid.UUID, stats entities.FrameFilterStats) error {
+	args := m.Called(ctx, id, stats)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateAudioInfo(ctx context.Context, id uuid.UUID, hasAudio bool, audioPath *string) error {
+	args := m.Called(ctx, id, hasAudio, audioPath)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateFrameRate(ctx context.Context, id uuid.UUID, frameRate float64) error {
+	args := m.Called(ctx, id, frameRate)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateSourceDuration(ctx context.Context, id uuid.UUID, duration float64) error {
+	args := m.Called(ctx, id, duration)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateActivityScores(ctx context.Context, id uuid.UUID, scores []byte) error {
+	args := m.Called(ctx, id, scores)
+	return args.Error(0)
+}
+
+// Mock SubtitleTrackRepository
+type MockSubtitleTrackRepository struct {
+	mock.Mock
+}
+
+func (m *MockSubtitleTrackRepository) ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, tracks []*entities.SubtitleTrack) error {
+	args := m.Called(ctx, videoID, tracks)
+	return args.Error(0)
+}
+
+// Mock ShotRepository
+type MockShotRepository struct {
+	mock.Mock
+}
+
+func (m *MockShotRepository) ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, shots []*entities.Shot) error {
+	args := m.Called(ctx, videoID, shots)
+	return args.Error(0)
+}
+
+// Mock FrameColorRepository
+type MockFrameColorRepository struct {
+	mock.Mock
+}
+
+func (m *MockFrameColorRepository) ReplaceByVideoID(ctx context.Context, videoID uuid.UUID, colors []*entities.FrameColor) error {
+	args := m.Called(ctx, videoID, colors)
+	return args.Error(0)
+}
+
+// Mock CheckpointRepository
+type MockCheckpointRepository struct {
+	mock.Mock
+}
+
+func (m *MockCheckpointRepository) FindByVideoID(ctx context.Context, videoID uuid.UUID) (*entities.Checkpoint, error) {
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Checkpoint), args.Error(1)
+}
+
+func (m *MockCheckpointRepository) SaveFrames(ctx context.Context, frames []*entities.CheckpointFrame) error {
+	args := m.Called(ctx, frames)
+	return args.Error(0)
+}
+
+func (m *MockCheckpointRepository) DeleteFrames(ctx context.Context, videoID uuid.UUID, frameIndexes []int) error {
+	args := m.Called(ctx, videoID, frameIndexes)
+	return args.Error(0)
+}
+
+func (m *MockCheckpointRepository) SaveStage(ctx context.Context, videoID uuid.UUID, stage string) error {
+	args := m.Called(ctx, videoID, stage)
+	return args.Error(0)
+}
+
+func (m *MockCheckpointRepository) DeleteByVideoID(ctx context.Context, videoID uuid.UUID) error {
+	args := m.Called(ctx, videoID)
+	return args.Error(0)
+}
+
+// newCheckpointRepository returns a checkpoint repository with no earlier
+// attempt recorded that accepts any progress.
+func newCheckpointRepository() *MockCheckpointRepository {
+	m := new(MockCheckpointRepository)
+	m.On("FindByVideoID", mock.Anything, mock.Anything).Return(&entities.Checkpoint{}, nil).Maybe()
+	m.On("SaveFrames", mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.On("DeleteFrames", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.On("SaveStage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.On("DeleteByVideoID", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return m
+}
+
 // Mock S3Client
 type MockS3Client struct {
 	mock.Mock
@@ -81,6 +209,16 @@ func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key str
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
 	args := m.Called(ctx, bucket, prefix)
 	if args.Get(0) == nil {
@@ -89,16 +227,121 @@ func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) (
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
 // Mock FFmpegService
 type MockFFmpegService struct {
 	mock.Mock
 }
 
-func (m *MockFFmpegService) ExtractFrames(ctx context.Context, videoPath, outputDir string, fps int) (int, error) {
-	args := m.Called(ctx, videoPath, outputDir, fps)
+func (m *MockFFmpegService) ExtractFrames(ctx context.Context, videoPath, outputDir string, opts ffmpeg.ExtractOptions) (int, error) {
+	args := m.Called(ctx, videoPath, outputDir, opts)
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockFFmpegService) GeneratePreview(ctx context.Context, videoPath, outputPath string, opts ffmpeg.PreviewOptions) error {
+	args := m.Called(ctx, videoPath, outputPath, opts)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) ExtractAudio(ctx context.Context, videoPath, outputPath, format string) error {
+	args := m.Called(ctx, videoPath, outputPath, format)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) ExtractSubtitle(ctx context.Context, videoPath, outputPath string, streamIndex int, format string) error {
+	args := m.Called(ctx, videoPath, outputPath, streamIndex, format)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) Probe(ctx context.Context, videoPath string) (*ffmpeg.ProbeResult, error) {
+	args := m.Called(ctx, videoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ffmpeg.ProbeResult), args.Error(1)
+}
+
+func (m *MockFFmpegService) DetectScenes(ctx context.Context, videoPath string, threshold float64) ([]float64, error) {
+	args := m.Called(ctx, videoPath, threshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]float64), args.Error(1)
+}
+
+func (m *MockFFmpegService) EncodeImageSequence(ctx context.Context, inputPattern, outputPath string, opts ffmpeg.EncodeOptions) error {
+	args := m.Called(ctx, inputPattern, outputPath, opts)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) Keyframes(ctx context.Context, videoPath string) ([]float64, error) {
+	args := m.Called(ctx, videoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]float64), args.Error(1)
+}
+
+func (m *MockFFmpegService) CutClip(ctx context.Context, videoPath, outputPath string, start, end float64, streamCopy bool) error {
+	args := m.Called(ctx, videoPath, outputPath, start, end, streamCopy)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) TranscodeHLS(ctx context.Context, videoPath, outputDir string, rendition ffmpeg.HLSRendition) error {
+	args := m.Called(ctx, videoPath, outputDir, rendition)
+	return args.Error(0)
+}
+
+// videoOnlyProbe is a probe result for a source without an audio stream.
+func videoOnlyProbe() *ffmpeg.ProbeResult {
+	return &ffmpeg.ProbeResult{
+		Duration: 10,
+		Streams:  []ffmpeg.Stream{{Index: 0, CodecType: "video", CodecName: "h264"}},
+	}
+}
+
+// writeTestOutput stands in for ffmpeg writing its output file.
+func writeTestOutput(args mock.Arguments) {
+	_ = os.WriteFile(args.String(2), []byte("fake output"), 0644)
+}
+
+// Mock StorageClient
+type MockStorageClient struct {
+	mock.Mock
+}
+
+func (m *MockStorageClient) CreateZip(ctx context.Context, req storage.CreateZipRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
 // Mock Publisher
 type MockPublisher struct {
 	mock.Mock
@@ -109,6 +352,11 @@ func (m *MockPublisher) Publish(ctx context.Context, queue string, message inter
 	return args.Error(0)
 }
 
+func (m *MockPublisher) PublishWithOptions(ctx context.Context, queue string, message interface{}, opts rabbitmq.PublishOptions) error {
+	args := m.Called(ctx, queue, message, opts)
+	return args.Error(0)
+}
+
 func (m *MockPublisher) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -117,8 +365,13 @@ func (m *MockPublisher) Close() error {
 func TestProcessUseCase_Execute_Success(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
 	mockS3 := new(MockS3Client)
 	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
 	mockPublisher := new(MockPublisher)
 
 	videoID := uuid.New()
@@ -131,19 +384,28 @@ func TestProcessUseCase_Execute_Success(t *testing.T) {
 
 	// Setup expectations
 	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, 10.0).Return(nil)
 	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
 
 	// Mock S3 download
 	videoContent := io.NopCloser(strings.NewReader("fake video content"))
 	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
 
 	// Mock FFmpeg frame extraction
-	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 1).Return(10, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
 
 	// Mock S3 frame uploads (may be called if FFmpeg creates actual files, but in unit tests it won't)
 	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil).Maybe()
 
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, "processed/"+videoID.String()+"/preview.gif").Return(nil)
+
 	// Mock repository update for completion
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
 	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.MatchedBy(func(path string) bool {
 		return strings.Contains(path, "video.mp4.zip")
 	})).Return(nil)
@@ -154,7 +416,7 @@ func TestProcessUseCase_Execute_Success(t *testing.T) {
 		return m["video_id"] == videoID.String() && m["status"] == "COMPLETED"
 	})).Return(nil)
 
-	useCase := NewProcessUseCase(mockRepo, mockS3, mockFFmpeg, mockPublisher, "processed-bucket")
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
 	err := useCase.Execute(ctx, cmd)
 
 	assert.NoError(t, err)
@@ -167,8 +429,13 @@ func TestProcessUseCase_Execute_Success(t *testing.T) {
 func TestProcessUseCase_Execute_MarkAsStartedError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
 	mockS3 := new(MockS3Client)
 	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
 	mockPublisher := new(MockPublisher)
 
 	videoID := uuid.New()
@@ -181,7 +448,7 @@ func TestProcessUseCase_Execute_MarkAsStartedError(t *testing.T) {
 
 	mockRepo.On("MarkAsStarted", ctx, videoID).Return(errors.New("database error"))
 
-	useCase := NewProcessUseCase(mockRepo, mockS3, mockFFmpeg, mockPublisher, "processed-bucket")
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
 	err := useCase.Execute(ctx, cmd)
 
 	assert.Error(t, err)
@@ -192,8 +459,13 @@ func TestProcessUseCase_Execute_MarkAsStartedError(t *testing.T) {
 func TestProcessUseCase_Execute_UpdateStatusError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
 	mockS3 := new(MockS3Client)
 	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
 	mockPublisher := new(MockPublisher)
 
 	videoID := uuid.New()
@@ -205,9 +477,10 @@ func TestProcessUseCase_Execute_UpdateStatusError(t *testing.T) {
 	}
 
 	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(errors.New("database error"))
 
-	useCase := NewProcessUseCase(mockRepo, mockS3, mockFFmpeg, mockPublisher, "processed-bucket")
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
 	err := useCase.Execute(ctx, cmd)
 
 	assert.Error(t, err)
@@ -218,8 +491,13 @@ func TestProcessUseCase_Execute_UpdateStatusError(t *testing.T) {
 func TestProcessUseCase_Execute_DownloadVideoError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
 	mockS3 := new(MockS3Client)
 	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
 	mockPublisher := new(MockPublisher)
 
 	videoID := uuid.New()
@@ -231,6 +509,7 @@ func TestProcessUseCase_Execute_DownloadVideoError(t *testing.T) {
 	}
 
 	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
 	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(nil, errors.New("s3 error"))
 
@@ -241,7 +520,7 @@ func TestProcessUseCase_Execute_DownloadVideoError(t *testing.T) {
 		return m["status"] == "FAILED"
 	})).Return(nil)
 
-	useCase := NewProcessUseCase(mockRepo, mockS3, mockFFmpeg, mockPublisher, "processed-bucket")
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
 	err := useCase.Execute(ctx, cmd)
 
 	assert.Error(t, err)
@@ -253,8 +532,13 @@ func TestProcessUseCase_Execute_DownloadVideoError(t *testing.T) {
 func TestProcessUseCase_Execute_FFmpegError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
 	mockS3 := new(MockS3Client)
 	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
 	mockPublisher := new(MockPublisher)
 
 	videoID := uuid.New()
@@ -266,12 +550,14 @@ func TestProcessUseCase_Execute_FFmpegError(t *testing.T) {
 	}
 
 	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
 
 	videoContent := io.NopCloser(strings.NewReader("fake video content"))
 	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
 
-	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 1).Return(0, errors.New("ffmpeg error"))
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(0, errors.New("ffmpeg error"))
 
 	// Expect error handling
 	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusFailed, mock.AnythingOfType("*string")).Return(nil)
@@ -280,7 +566,7 @@ func TestProcessUseCase_Execute_FFmpegError(t *testing.T) {
 		return m["status"] == "FAILED"
 	})).Return(nil)
 
-	useCase := NewProcessUseCase(mockRepo, mockS3, mockFFmpeg, mockPublisher, "processed-bucket")
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
 	err := useCase.Execute(ctx, cmd)
 
 	assert.Error(t, err)
@@ -302,8 +588,13 @@ func TestProcessUseCase_Execute_UploadFramesError(t *testing.T) {
 func TestProcessUseCase_Execute_UpdateCompletionError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
 	mockS3 := new(MockS3Client)
 	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
 	mockPublisher := new(MockPublisher)
 
 	videoID := uuid.New()
@@ -315,14 +606,22 @@ func TestProcessUseCase_Execute_UpdateCompletionError(t *testing.T) {
 	}
 
 	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
 
 	videoContent := io.NopCloser(strings.NewReader("fake video content"))
 	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
 
-	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 1).Return(10, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
 	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
 
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
 	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(errors.New("database error"))
 
 	// Expect error handling
@@ -332,7 +631,7 @@ func TestProcessUseCase_Execute_UpdateCompletionError(t *testing.T) {
 		return m["status"] == "FAILED"
 	})).Return(nil)
 
-	useCase := NewProcessUseCase(mockRepo, mockS3, mockFFmpeg, mockPublisher, "processed-bucket")
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
 	err := useCase.Execute(ctx, cmd)
 
 	assert.Error(t, err)
@@ -343,8 +642,13 @@ func TestProcessUseCase_Execute_UpdateCompletionError(t *testing.T) {
 func TestProcessUseCase_Execute_NotificationPublishError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
 	mockS3 := new(MockS3Client)
 	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
 	mockPublisher := new(MockPublisher)
 
 	videoID := uuid.New()
@@ -356,22 +660,1574 @@ func TestProcessUseCase_Execute_NotificationPublishError(t *testing.T) {
 	}
 
 	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
 
 	videoContent := io.NopCloser(strings.NewReader("fake video content"))
 	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
 
-	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 1).Return(10, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
 	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
 	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
 
 	// Notification publish fails, but should not fail the use case
 	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(errors.New("rabbitmq error"))
 
-	useCase := NewProcessUseCase(mockRepo, mockS3, mockFFmpeg, mockPublisher, "processed-bucket")
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
 	err := useCase.Execute(ctx, cmd)
 
 	// Should still succeed even if notification fails
 	assert.NoError(t, err)
 	mockPublisher.AssertExpectations(t)
 }
+
+// writeTestFramesFrom writes one frame per image, numbered from 1.
+func writeTestFramesFrom(t *testing.T, dir string, images []image.Image) {
+	t.Helper()
+	for i, img := range images {
+		path := filepath.Join(dir, fmt.Sprintf("frame_%04d.jpg", i+1))
+		if err := imaging.EncodeJPEGFile(path, img, 90); err != nil {
+			t.Fatalf("failed to write test frame: %v", err)
+		}
+	}
+}
+
+// stripedImage renders vertical bars of the given width so that different
+// widths produce clearly different perceptual hashes.
+func stripedImage(barWidth int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 36))
+	for y := 0; y < 36; y++ {
+		for x := 0; x < 64; x++ {
+			if (x/barWidth)%2 == 0 {
+				img.Set(x, y, color.White)
+			} else {
+				img.Set(x, y, color.Black)
+			}
+		}
+	}
+	return img
+}
+
+func writeTestFrames(t *testing.T, dir string, count int) {
+	t.Helper()
+	for i := 1; i <= count; i++ {
+		img := image.NewRGBA(image.Rect(0, 0, 64, 36))
+		path := filepath.Join(dir, fmt.Sprintf("frame_%04d.jpg", i))
+		if err := imaging.EncodeJPEGFile(path, img, 75); err != nil {
+			t.Fatalf("failed to write test frame: %v", err)
+		}
+	}
+}
+
+// testFrameData returns the contents of an extracted frame as stored in S3.
+func testFrameData(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeTestFramesFrom(t, dir, []image.Image{stripedImage(4)})
+	data, err := os.ReadFile(filepath.Join(dir, "frame_0001.jpg"))
+	if err != nil {
+		t.Fatalf("failed to read test frame: %v", err)
+	}
+	return string(data)
+}
+
+func TestProcessUseCase_Execute_GeneratesMosaics(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).
+		Run(func(args mock.Arguments) { writeTestFrames(t, args.String(2), 3) }).
+		Return(3, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+
+	prefix := "processed/" + videoID.String() + "/"
+	mockS3.On("Upload", ctx, "processed-bucket", mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, prefix+"frames/")
+	}), mock.Anything).Return(nil).Times(3)
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"manifest.json", mock.Anything).Return(nil).Once()
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"manifest.csv", mock.Anything).Return(nil).Once()
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"contact-sheets/contact_001.jpg", mock.Anything).Return(nil).Once()
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"sprites/sprite_001.jpg", mock.Anything).Return(nil).Once()
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"sprites/thumbnails.vtt", mock.Anything).Return(nil).Once()
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"preview.gif", mock.Anything).Return(nil).Once()
+
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, prefix+"preview.gif").Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 3, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockS3.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_GeneratesMP4Preview(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Preview: commands.PreviewOptions{Format: "mp4", Duration: 6},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.PreviewOptions{
+		Format:   "mp4",
+		Duration: 6,
+		Width:    320,
+	}).Run(writeTestOutput).Return(nil)
+
+	previewKey := "processed/" + videoID.String() + "/preview.mp4"
+	mockS3.On("Upload", ctx, "processed-bucket", previewKey, mock.Anything).Return(nil).Once()
+
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, previewKey).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockS3.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+	mockFFmpeg.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_PreviewError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).Return(errors.New("ffmpeg error"))
+
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusFailed, mock.AnythingOfType("*string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.MatchedBy(func(msg interface{}) bool {
+		m := msg.(map[string]interface{})
+		return m["status"] == "FAILED"
+	})).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to generate preview")
+	mockRepo.AssertNotCalled(t, "UpdateProcessingComplete", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProcessUseCase_Execute_ExtractsAudio(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Audio: commands.AudioOptions{Format: "flac", IncludeInZip: true},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+
+	probe := videoOnlyProbe()
+	probe.Streams = append(probe.Streams, ffmpeg.Stream{Index: 1, CodecType: "audio", CodecName: "aac"})
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(probe, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockFFmpeg.On("ExtractAudio", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), "flac").
+		Run(writeTestOutput).
+		Return(nil)
+
+	audioKey := "processed/" + videoID.String() + "/audio/audio.flac"
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, true, mock.MatchedBy(func(path *string) bool {
+		return path != nil && *path == audioKey
+	})).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.MatchedBy(func(req storage.CreateZipRequest) bool {
+		return req.S3Prefix == "processed/"+videoID.String()+"/frames/" && len(req.ExtraKeys) == 1 && req.ExtraKeys[0] == audioKey
+	})).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockS3.AssertCalled(t, "Upload", ctx, "processed-bucket", audioKey, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockFFmpeg.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_AudioRequestedWithoutAudioStream(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Audio: commands.AudioOptions{Format: "mp3", IncludeInZip: true},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.MatchedBy(func(req storage.CreateZipRequest) bool {
+		return len(req.ExtraKeys) == 0
+	})).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockFFmpeg.AssertNotCalled(t, "ExtractAudio", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_ExtractsSubtitles(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Subtitles: commands.SubtitleOptions{IncludeInZip: true},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+
+	probe := videoOnlyProbe()
+	probe.Streams = append(probe.Streams,
+		ffmpeg.Stream{Index: 2, CodecType: "subtitle", CodecName: "subrip", Language: "eng"},
+		ffmpeg.Stream{Index: 3, CodecType: "subtitle", CodecName: "hdmv_pgs_subtitle", Language: "fra"},
+	)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(probe, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockFFmpeg.On("ExtractSubtitle", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 2, "srt").
+		Run(writeTestOutput).
+		Return(nil).Once()
+	mockFFmpeg.On("ExtractSubtitle", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), 2, "vtt").
+		Run(writeTestOutput).
+		Return(nil).Once()
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	prefix := "processed/" + videoID.String() + "/subtitles/"
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockSubtitleRepo.On("ReplaceByVideoID", ctx, videoID, mock.MatchedBy(func(tracks []*entities.SubtitleTrack) bool {
+		return len(tracks) == 1 &&
+			tracks[0].Language == "eng" &&
+			tracks[0].SRTPath == prefix+"track_2_eng.srt" &&
+			tracks[0].VTTPath == prefix+"track_2_eng.vtt"
+	})).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.MatchedBy(func(req storage.CreateZipRequest) bool {
+		return len(req.ExtraKeys) == 2 && req.ExtraKeys[0] == prefix+"track_2_eng.srt" && req.ExtraKeys[1] == prefix+"track_2_eng.vtt"
+	})).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockFFmpeg.AssertExpectations(t)
+	mockSubtitleRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_DedupesFrames(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Dedupe: commands.DedupeOptions{Enabled: true},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+
+	// Frames 1-3 are the same static shot, frame 4 is a different one.
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).
+		Run(func(args mock.Arguments) {
+			still := stripedImage(4)
+			writeTestFramesFrom(t, args.String(2), []image.Image{still, still, still, stripedImage(16)})
+		}).
+		Return(4, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+
+	// Every frame is uploaded as it is extracted; the duplicates are deleted
+	// again once the filter has run.
+	framePrefix := "processed/" + videoID.String() + "/frames/"
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	mockS3.On("DeleteMultiple", ctx, "processed-bucket", []string{framePrefix + "frame_0002.jpg", framePrefix + "frame_0003.jpg"}).Return(nil).Once()
+
+	mockRepo.On("UpdateFrameFilterStats", ctx, videoID, entities.FrameFilterStats{DuplicateFrames: 2}).Return(nil)
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 2, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockS3.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+	mockCheckpointRepo.AssertCalled(t, "DeleteFrames", ctx, videoID, []int{2, 3})
+}
+
+func TestFilterFrames_DropsLowQualityFrames(t *testing.T) {
+	dir := t.TempDir()
+
+	gray := image.NewUniform(color.Gray{Y: 128})
+	blank := image.NewRGBA(image.Rect(0, 0, 64, 36))
+	draw.Draw(blank, blank.Bounds(), gray, image.Point{}, draw.Src)
+
+	// A smooth ramp is bright and varied but has no edges, like a soft focus.
+	blurry := image.NewGray(image.Rect(0, 0, 64, 36))
+	for y := 0; y < 36; y++ {
+		for x := 0; x < 64; x++ {
+			blurry.SetGray(x, y, color.Gray{Y: uint8(x * 4)})
+		}
+	}
+
+	black := image.NewRGBA(image.Rect(0, 0, 64, 36))
+	writeTestFramesFrom(t, dir, []image.Image{black, blank, blurry, stripedImage(4)})
+
+	frames, err := listFrames(dir, 1)
+	assert.NoError(t, err)
+
+	kept, stats, err := filterFrames(frames, commands.ProcessingOptions{
+		Quality: commands.QualityOptions{Enabled: true},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, stats.LowQualityFrames)
+	assert.Len(t, kept, 1)
+	assert.Equal(t, "frame_0004.jpg", kept[0].Filename)
+	assert.NotNil(t, kept[0].Quality)
+	assert.Greater(t, kept[0].Quality.Sharpness, 50.0)
+
+	_, err = os.Stat(filepath.Join(dir, "frame_0001.jpg"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestProcessUseCase_Execute_KeepsActiveFrames(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Activity: commands.ActivityOptions{Enabled: true, KeepActiveOnly: true},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+
+	// Nothing moves except for the change between frames 3 and 4.
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).
+		Run(func(args mock.Arguments) {
+			before, after := stripedImage(4), stripedImage(16)
+			writeTestFramesFrom(t, args.String(2), []image.Image{before, before, before, after, after, after, after})
+		}).
+		Return(7, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+
+	framePrefix := "processed/" + videoID.String() + "/frames/"
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	mockS3.On("DeleteMultiple", ctx, "processed-bucket", []string{
+		framePrefix + "frame_0001.jpg", framePrefix + "frame_0002.jpg", framePrefix + "frame_0006.jpg", framePrefix + "frame_0007.jpg",
+	}).Return(nil).Once()
+
+	mockRepo.On("UpdateActivityScores", ctx, videoID, mock.MatchedBy(func(scores []byte) bool {
+		return len(scores) == 7 && scores[2] == 0 && scores[3] > 0 && scores[4] == 0
+	})).Return(nil)
+	mockRepo.On("UpdateFrameFilterStats", ctx, videoID, entities.FrameFilterStats{InactiveFrames: 4}).Return(nil)
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 3, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockS3.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_DetectsShots(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Shots: commands.ShotOptions{Enabled: true},
+		},
+	}
+
+	probe := videoOnlyProbe()
+	probe.Streams[0].FrameRate = 25
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(probe, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).
+		Run(func(args mock.Arguments) {
+			writeTestFrames(t, args.String(2), 10)
+		}).
+		Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockFFmpeg.On("DetectScenes", ctx, mock.AnythingOfType("string"), 0.4).Return([]float64{4.2}, nil)
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	mockShotRepo.On("ReplaceByVideoID", ctx, videoID, mock.MatchedBy(func(shots []*entities.Shot) bool {
+		return len(shots) == 2 && shots[0].EndTime == 4.2 && shots[1].StartTime == 4.2 && shots[1].EndTime == 10
+	})).Return(nil)
+	mockRepo.On("UpdateFrameRate", ctx, videoID, 25.0).Return(nil)
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockShotRepo.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBuildShots(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFrames(t, dir, 10)
+
+	frames, err := listFrames(dir, 1)
+	assert.NoError(t, err)
+
+	probe := videoOnlyProbe()
+	probe.StartTime = 1
+
+	// Cuts are reported in stream time; the repeated and out-of-range cuts
+	// must not produce empty shots.
+	videoID := uuid.New()
+	shots := buildShots(videoID, []float64{3.5, 3.5, 7, 12}, frames, probe)
+
+	assert.Len(t, shots, 3)
+	assert.Equal(t, 0.0, shots[0].StartTime)
+	assert.Equal(t, 2.5, shots[0].EndTime)
+	assert.Equal(t, 6.0, shots[1].EndTime)
+	assert.Equal(t, 10.0, shots[2].EndTime)
+	assert.Equal(t, 3, shots[2].Index)
+	assert.Equal(t, "processed/"+videoID.String()+"/frames/frame_0002.jpg", *shots[0].FramePath)
+	assert.Equal(t, "processed/"+videoID.String()+"/frames/frame_0009.jpg", *shots[2].FramePath)
+}
+
+func TestAnalyzeColors(t *testing.T) {
+	dir := t.TempDir()
+
+	// Left half pure red, right half pure blue.
+	img := image.NewRGBA(image.Rect(0, 0, 64, 36))
+	for y := 0; y < 36; y++ {
+		for x := 0; x < 64; x++ {
+			if x < 32 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+	writeTestFramesFrom(t, dir, []image.Image{img})
+
+	frames, err := listFrames(dir, 1)
+	assert.NoError(t, err)
+
+	videoID := uuid.New()
+	rows, err := analyzeColors(videoID, frames, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, 1, rows[0].Rank)
+	assert.Equal(t, 1, rows[0].FrameIndex)
+	assert.InDelta(t, 0.5, rows[0].Weight, 0.05)
+
+	hexes := []string{rows[0].Hex, rows[1].Hex}
+	assert.True(t, rows[0].R > 200 || rows[1].R > 200, "expected a red color in %v", hexes)
+	assert.True(t, rows[0].B > 200 || rows[1].B > 200, "expected a blue color in %v", hexes)
+
+	var total float64
+	for _, share := range frames[0].Colors.Histogram[0] {
+		total += share
+	}
+	assert.InDelta(t, 1.0, total, 0.001)
+}
+
+func TestProcessUseCase_Execute_GeneratesHLS(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			HLS: commands.HLSOptions{Enabled: true},
+		},
+	}
+
+	probe := videoOnlyProbe()
+	probe.Streams[0].Width = 1280
+	probe.Streams[0].Height = 720
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(probe, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: &ffmpeg.VideoPipeline{}}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockFFmpeg.On("TranscodeHLS", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("ffmpeg.HLSRendition")).
+		Run(func(args mock.Arguments) {
+			dir := args.String(2)
+			_ = os.WriteFile(filepath.Join(dir, "index.m3u8"), []byte("#EXTM3U"), 0644)
+			_ = os.WriteFile(filepath.Join(dir, "segment_0000.ts"), []byte("segment"), 0644)
+		}).
+		Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	masterKey := "processed/" + videoID.String() + "/hls/master.m3u8"
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockRepo.On("UpdateHLSPath", ctx, videoID, masterKey).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	// The 1080p rung is taller than the source and is skipped.
+	mockFFmpeg.AssertNumberOfCalls(t, "TranscodeHLS", 2)
+	mockS3.AssertCalled(t, "Upload", ctx, "processed-bucket", "processed/"+videoID.String()+"/hls/360p/segment_0000.ts", mock.Anything)
+	mockS3.AssertCalled(t, "Upload", ctx, "processed-bucket", "processed/"+videoID.String()+"/hls/720p/index.m3u8", mock.Anything)
+	mockS3.AssertCalled(t, "Upload", ctx, "processed-bucket", masterKey, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_AppliesWatermark(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	assetID := uuid.New().String()
+	opacity := 0.8
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   7,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Watermark: commands.WatermarkOptions{
+				AssetID: assetID,
+				Text:    "{filename} #{frame}",
+				Opacity: &opacity,
+			},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	watermark := io.NopCloser(strings.NewReader("fake png"))
+	mockS3.On("GetObject", ctx, "", "assets/7/watermarks/"+assetID+".png").Return(watermark, nil)
+
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(opts ffmpeg.ExtractOptions) bool {
+		overlay := opts.Overlay
+		if opts.FPS != 1 || overlay == nil {
+			return false
+		}
+		content, err := os.ReadFile(overlay.ImagePath)
+		return err == nil && string(content) == "fake png" &&
+			overlay.Text == "{filename} #{frame}" &&
+			overlay.Filename == "video.mp4" &&
+			overlay.Position == "bottom-right" &&
+			overlay.Opacity == 0.8 &&
+			overlay.FontSize == 24
+	})).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockFFmpeg.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_CorrectsVideoFromProbe(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	streamIndex := 2
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Video: commands.VideoOptions{StreamIndex: &streamIndex, Deinterlace: "bwdif"},
+		},
+	}
+
+	// The second video stream is an interlaced, HDR, portrait recording;
+	// deinterlacing is forced to bwdif, the rest follows the probe.
+	probe := videoOnlyProbe()
+	probe.Streams = append(probe.Streams,
+		ffmpeg.Stream{Index: 1, CodecType: "audio", CodecName: "aac"},
+		ffmpeg.Stream{Index: 2, CodecType: "video", CodecName: "hevc", Rotation: 90, FieldOrder: "tt", ColorTransfer: "smpte2084"},
+	)
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(probe, nil)
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{
+		FPS: 1,
+		Video: &ffmpeg.VideoPipeline{
+			StreamIndex: 2,
+			Rotation:    90,
+			Deinterlace: "bwdif",
+			ToneMap:     true,
+		},
+	}).Return(10, nil)
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, true, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 10, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockFFmpeg.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_RejectsNonVideoStream(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	streamIndex := 1
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Video: commands.VideoOptions{StreamIndex: &streamIndex},
+		},
+	}
+
+	probe := videoOnlyProbe()
+	probe.Streams = append(probe.Streams, ffmpeg.Stream{Index: 1, CodecType: "audio", CodecName: "aac"})
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(probe, nil)
+
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusFailed, mock.MatchedBy(func(msg *string) bool {
+		return msg != nil && *msg == "stream 1 is not a video stream"
+	})).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.Error(t, err)
+	mockFFmpeg.AssertNotCalled(t, "ExtractFrames", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_SourceOverLimits(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+	}
+
+	probe := videoOnlyProbe()
+	probe.Streams[0].Width = 7680
+	probe.Streams[0].Height = 4320
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(probe, nil)
+
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusFailed, mock.Anything).Return(nil)
+	mockRepo.On("UpdateErrorCode", ctx, videoID, entities.ErrorCodeResolutionExceeded).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.MatchedBy(func(msg map[string]interface{}) bool {
+		return msg["status"] == "FAILED" && msg["error_code"] == entities.ErrorCodeResolutionExceeded
+	})).Return(nil)
+
+	limits := SourceLimits{MaxDuration: time.Hour, MaxDimension: 4096, AllowedCodecs: []string{"h264"}}
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", limits, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	// The rejection is recorded, and the job is acked rather than retried.
+	assert.NoError(t, err)
+	mockFFmpeg.AssertNotCalled(t, "ExtractFrames", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_InsufficientScratchSpace(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		FileSize: 1 << 62,
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusFailed, mock.MatchedBy(func(msg *string) bool {
+		return msg != nil && strings.HasPrefix(*msg, "insufficient scratch space")
+	})).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{ScratchDir: t.TempDir()})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.Error(t, err)
+	mockS3.AssertNotCalled(t, "GetObject", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_Timeout(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := newCheckpointRepository()
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", mock.Anything, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+	mockFFmpeg.On("ExtractFrames", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).
+		Return(0, errors.New("ffmpeg failed: signal: killed"))
+
+	mockRepo.On("UpdateStatus", mock.Anything, videoID, entities.StatusFailed, mock.MatchedBy(func(msg *string) bool {
+		return msg != nil && strings.HasPrefix(*msg, "processing timed out")
+	})).Return(nil)
+	mockPublisher.On("Publish", mock.Anything, "video.notification.queue", mock.Anything).Return(nil)
+
+	limits := JobLimits{TimeoutBase: time.Millisecond}
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, limits)
+	err := useCase.Execute(ctx, cmd)
+
+	// The failure is recorded, and the job is acked rather than retried.
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_ResumesExtraction(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := new(MockCheckpointRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(&ffmpeg.ProbeResult{
+		Duration: 700,
+		Streams:  []ffmpeg.Stream{{Index: 0, CodecType: "video", CodecName: "h264"}},
+	}, nil)
+
+	// The first attempt finished two of the three segments, but the second
+	// segment's frame has since changed in storage.
+	framePrefix := "processed/" + videoID.String() + "/frames/"
+	frameData := testFrameData(t)
+	frameETag := fmt.Sprintf("%x", md5.Sum([]byte(frameData)))
+	mockCheckpointRepo.On("FindByVideoID", ctx, videoID).Return(&entities.Checkpoint{
+		Frames: []*entities.CheckpointFrame{
+			{VideoID: videoID, FrameIndex: 1, Segment: 0, Filename: "frame_0001.jpg", Size: int64(len(frameData)), ETag: frameETag},
+			{VideoID: videoID, FrameIndex: 301, Segment: 1, Filename: "frame_0301.jpg", Size: 10, ETag: "aaaa"},
+		},
+		Stages: []*entities.CheckpointStage{
+			{VideoID: videoID, Stage: "extract:0"},
+			{VideoID: videoID, Stage: "extract:1"},
+		},
+	}, nil)
+	mockS3.On("ListObjectDetails", ctx, "processed-bucket", framePrefix).Return([]s3.ObjectInfo{
+		{Key: framePrefix + "frame_0001.jpg", Size: int64(len(frameData)), ETag: frameETag},
+		{Key: framePrefix + "frame_0301.jpg", Size: 10, ETag: "bbbb"},
+		{Key: framePrefix + "frame_0650.jpg", Size: 10, ETag: "cccc"},
+	}, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", framePrefix+"frame_0001.jpg").
+		Return(io.NopCloser(strings.NewReader(frameData)), nil)
+	mockS3.On("DeleteMultiple", ctx, "processed-bucket", []string{framePrefix + "frame_0301.jpg", framePrefix + "frame_0650.jpg"}).Return(nil)
+
+	pipeline := &ffmpeg.VideoPipeline{}
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: pipeline, Start: 300, Duration: 300, StartNumber: 301}).Return(300, nil).Once()
+	mockFFmpeg.On("ExtractFrames", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), ffmpeg.ExtractOptions{FPS: 1, Video: pipeline, Start: 600, Duration: 300, StartNumber: 601}).Return(100, nil).Once()
+	mockFFmpeg.On("GeneratePreview", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).
+		Run(writeTestOutput).
+		Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	mockCheckpointRepo.On("SaveFrames", ctx, mock.Anything).Return(nil)
+	mockCheckpointRepo.On("SaveStage", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockCheckpointRepo.On("DeleteByVideoID", ctx, videoID).Return(nil)
+
+	mockRepo.On("UpdatePreviewPath", ctx, videoID, mock.AnythingOfType("string")).Return(nil)
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 401, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockFFmpeg.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+	mockCheckpointRepo.AssertCalled(t, "SaveStage", ctx, videoID, "extract:1")
+	mockCheckpointRepo.AssertCalled(t, "SaveStage", ctx, videoID, "extract:2")
+	mockCheckpointRepo.AssertNotCalled(t, "SaveStage", ctx, videoID, "extract:0")
+}
+
+func TestProcessUseCase_Execute_SkipsCompletedStages(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := new(MockCheckpointRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Dedupe: commands.DedupeOptions{Enabled: true},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+
+	// The first attempt got as far as the audio, so only that and the
+	// archive are left.
+	framePrefix := "processed/" + videoID.String() + "/frames/"
+	frameData := testFrameData(t)
+	frameETag := fmt.Sprintf("%x", md5.Sum([]byte(frameData)))
+	checkpoint := &entities.Checkpoint{
+		Frames: []*entities.CheckpointFrame{
+			{VideoID: videoID, FrameIndex: 4, Segment: 0, Filename: "frame_0004.jpg", Size: int64(len(frameData)), ETag: frameETag},
+		},
+	}
+	for _, stage := range []string{"extract:0", "filter", "manifest", "mosaics", "preview"} {
+		checkpoint.Stages = append(checkpoint.Stages, &entities.CheckpointStage{VideoID: videoID, Stage: stage})
+	}
+	mockCheckpointRepo.On("FindByVideoID", ctx, videoID).Return(checkpoint, nil)
+	mockS3.On("ListObjectDetails", ctx, "processed-bucket", framePrefix).Return([]s3.ObjectInfo{
+		{Key: framePrefix + "frame_0004.jpg", Size: int64(len(frameData)), ETag: frameETag},
+	}, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", framePrefix+"frame_0004.jpg").
+		Return(io.NopCloser(strings.NewReader(frameData)), nil)
+	mockCheckpointRepo.On("DeleteByVideoID", ctx, videoID).Return(nil)
+
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 1, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockFFmpeg.AssertNotCalled(t, "ExtractFrames", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockFFmpeg.AssertNotCalled(t, "GeneratePreview", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockS3.AssertNotCalled(t, "DeleteMultiple", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateFrameFilterStats", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockCheckpointRepo.AssertExpectations(t)
+}
+
+func TestProcessUseCase_Execute_RestoresActivityAfterFilterStage(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockVideoRepository)
+	mockSubtitleRepo := new(MockSubtitleTrackRepository)
+	mockShotRepo := new(MockShotRepository)
+	mockColorRepo := new(MockFrameColorRepository)
+	mockCheckpointRepo := new(MockCheckpointRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+	mockStorage := new(MockStorageClient)
+	mockPublisher := new(MockPublisher)
+
+	videoID := uuid.New()
+	cmd := commands.ProcessCommand{
+		VideoID:  videoID,
+		UserID:   1,
+		S3Key:    "uploads/video.mp4",
+		Filename: "video.mp4",
+		Options: commands.ProcessingOptions{
+			Activity: commands.ActivityOptions{Enabled: true, KeepActiveOnly: true},
+		},
+	}
+
+	mockRepo.On("MarkAsStarted", ctx, videoID).Return(nil)
+	mockRepo.On("UpdateSourceDuration", mock.Anything, videoID, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("UpdateStatus", ctx, videoID, entities.StatusProcessing, (*string)(nil)).Return(nil)
+
+	videoContent := io.NopCloser(strings.NewReader("fake video content"))
+	mockS3.On("GetObject", ctx, "", "uploads/video.mp4").Return(videoContent, nil)
+	mockFFmpeg.On("Probe", ctx, mock.AnythingOfType("string")).Return(videoOnlyProbe(), nil)
+
+	// The first attempt filtered the frames down to frame 4 but failed
+	// before writing the manifest.
+	framePrefix := "processed/" + videoID.String() + "/frames/"
+	frameData := testFrameData(t)
+	frameETag := fmt.Sprintf("%x", md5.Sum([]byte(frameData)))
+	checkpoint := &entities.Checkpoint{
+		Frames: []*entities.CheckpointFrame{
+			{VideoID: videoID, FrameIndex: 4, Segment: 0, Filename: "frame_0004.jpg", Size: int64(len(frameData)), ETag: frameETag},
+		},
+	}
+	for _, stage := range []string{"extract:0", "filter", "mosaics", "preview"} {
+		checkpoint.Stages = append(checkpoint.Stages, &entities.CheckpointStage{VideoID: videoID, Stage: stage})
+	}
+	mockCheckpointRepo.On("FindByVideoID", ctx, videoID).Return(checkpoint, nil)
+	mockS3.On("ListObjectDetails", ctx, "processed-bucket", framePrefix).Return([]s3.ObjectInfo{
+		{Key: framePrefix + "frame_0004.jpg", Size: int64(len(frameData)), ETag: frameETag},
+	}, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", framePrefix+"frame_0004.jpg").
+		Return(io.NopCloser(strings.NewReader(frameData)), nil)
+	mockRepo.On("FindByID", ctx, videoID).Return(&entities.Video{ID: videoID, ActivityScores: []byte{0, 0, 0, 42, 0}}, nil)
+
+	var manifestJSON, manifestCSV []byte
+	prefix := "processed/" + videoID.String() + "/"
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"manifest.json", mock.Anything).
+		Run(func(args mock.Arguments) { manifestJSON, _ = io.ReadAll(args.Get(3).(io.Reader)) }).
+		Return(nil).Once()
+	mockS3.On("Upload", ctx, "processed-bucket", prefix+"manifest.csv", mock.Anything).
+		Run(func(args mock.Arguments) { manifestCSV, _ = io.ReadAll(args.Get(3).(io.Reader)) }).
+		Return(nil).Once()
+	mockCheckpointRepo.On("SaveStage", ctx, videoID, "manifest").Return(nil)
+	mockCheckpointRepo.On("DeleteByVideoID", ctx, videoID).Return(nil)
+
+	mockRepo.On("UpdateAudioInfo", ctx, videoID, false, (*string)(nil)).Return(nil)
+	mockStorage.On("CreateZip", ctx, mock.AnythingOfType("storage.CreateZipRequest")).Return(nil)
+	mockRepo.On("UpdateProcessingComplete", ctx, videoID, 1, mock.AnythingOfType("string")).Return(nil)
+	mockPublisher.On("Publish", ctx, "video.notification.queue", mock.Anything).Return(nil)
+
+	useCase := NewProcessUseCase(mockRepo, mockSubtitleRepo, mockShotRepo, mockColorRepo, mockCheckpointRepo, mockS3, mockFFmpeg, mockStorage, mockPublisher, "processed-bucket", SourceLimits{}, JobLimits{})
+	err := useCase.Execute(ctx, cmd)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "UpdateActivityScores", mock.Anything, mock.Anything, mock.Anything)
+
+	var m manifest
+	assert.NoError(t, json.Unmarshal(manifestJSON, &m))
+	if assert.Len(t, m.Frames, 1) && assert.NotNil(t, m.Frames[0].Activity) {
+		assert.Equal(t, 4, m.Frames[0].Index)
+		assert.Equal(t, 42, *m.Frames[0].Activity)
+	}
+	assert.True(t, strings.HasSuffix(strings.TrimSpace(string(manifestCSV)), ",42"))
+}
+
+func TestPlanSegments(t *testing.T) {
+	assert.Equal(t, []segment{{}}, planSegments(10, 1))
+	assert.Equal(t, []segment{{}}, planSegments(0, 1))
+
+	segments := planSegments(700, 1)
+	assert.Equal(t, []segment{
+		{Index: 0, Start: 0, Duration: 300, FirstFrame: 1},
+		{Index: 1, Start: 300, Duration: 300, FirstFrame: 301},
+		{Index: 2, Start: 600, Duration: 300, FirstFrame: 601},
+	}, segments)
+
+	assert.True(t, segments[1].contains(301, 1))
+	assert.True(t, segments[1].contains(600, 1))
+	assert.False(t, segments[1].contains(601, 1))
+	assert.True(t, segment{}.contains(10000, 1))
+}
+
+func TestJobLimits_WithTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	unlimited, cancel := JobLimits{}.withTimeout(ctx, 60)
+	defer cancel()
+	assert.Equal(t, ctx, unlimited)
+
+	limited, cancel := JobLimits{TimeoutBase: time.Minute, TimeoutFactor: 2}.withTimeout(ctx, 30)
+	defer cancel()
+	deadline, ok := limited.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), deadline, time.Second)
+}
+
+func TestEstimateOutput(t *testing.T) {
+	probe := videoOnlyProbe()
+	probe.Streams[0].Width = 1920
+	probe.Streams[0].Height = 1080
+	pipeline := &ffmpeg.VideoPipeline{StreamIndex: 0}
+
+	frames := estimateOutput(probe, pipeline, commands.ProcessingOptions{})
+	assert.Equal(t, uint64(10*1920*1080/2), frames)
+
+	withHLS := estimateOutput(probe, pipeline, commands.ProcessingOptions{
+		HLS: commands.HLSOptions{Enabled: true, Renditions: []int{360}},
+	})
+	assert.Equal(t, frames+uint64(10*(800+128)*1000/8), withHLS)
+}
+
+func TestValidateSource(t *testing.T) {
+	limits := SourceLimits{MaxDuration: time.Hour, MaxDimension: 4096, AllowedCodecs: []string{"h264", "hevc"}}
+
+	tests := []struct {
+		name   string
+		modify func(probe *ffmpeg.ProbeResult)
+		index  int
+		code   string
+	}{
+		{name: "within limits", modify: func(probe *ffmpeg.ProbeResult) {}},
+		{name: "no video stream", index: 1, modify: func(probe *ffmpeg.ProbeResult) {
+			probe.Streams = append(probe.Streams, ffmpeg.Stream{Index: 1, CodecType: "audio", CodecName: "aac"})
+		}, code: entities.ErrorCodeNoVideoStream},
+		{name: "too long", modify: func(probe *ffmpeg.ProbeResult) {
+			probe.Duration = 3601
+		}, code: entities.ErrorCodeDurationExceeded},
+		{name: "too tall", modify: func(probe *ffmpeg.ProbeResult) {
+			probe.Streams[0].Height = 5000
+		}, code: entities.ErrorCodeResolutionExceeded},
+		{name: "codec case insensitive", modify: func(probe *ffmpeg.ProbeResult) {
+			probe.Streams[0].CodecName = "HEVC"
+		}},
+		{name: "unsupported codec", modify: func(probe *ffmpeg.ProbeResult) {
+			probe.Streams[0].CodecName = "theora"
+		}, code: entities.ErrorCodeUnsupportedCodec},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe := videoOnlyProbe()
+			probe.Streams[0].Width = 1920
+			probe.Streams[0].Height = 1080
+			tt.modify(probe)
+
+			err := validateSource(probe, tt.index, limits)
+
+			if tt.code == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var rejected *sourceError
+			assert.ErrorAs(t, err, &rejected)
+			assert.Equal(t, tt.code, rejected.code)
+		})
+	}
+
+	// Zero limits accept anything with a video stream.
+	probe := videoOnlyProbe()
+	probe.Duration = 86400
+	probe.Streams[0].CodecName = "theora"
+	assert.NoError(t, validateSource(probe, 0, SourceLimits{}))
+}
+
+func TestHLSLadder(t *testing.T) {
+	probe := videoOnlyProbe()
+	probe.Streams[0].Width = 1920
+	probe.Streams[0].Height = 800
+	probe.Streams = append(probe.Streams, ffmpeg.Stream{Index: 1, CodecType: "audio", CodecName: "aac"})
+
+	ladder, err := hlsLadder(probe, &ffmpeg.VideoPipeline{}, []int{720, 360, 1080, 360})
+
+	assert.NoError(t, err)
+	assert.Len(t, ladder, 2)
+	assert.Equal(t, "360p", ladder[0].Name)
+	assert.Equal(t, 864, ladder[0].Width)
+	assert.Equal(t, 128, ladder[0].AudioBitrate)
+	assert.Equal(t, "720p", ladder[1].Name)
+	assert.Equal(t, 1728, ladder[1].Width)
+	assert.Equal(t, 2800, ladder[1].VideoBitrate)
+
+	// Too small for every rung: one rendition at the source height.
+	probe = videoOnlyProbe()
+	probe.Streams[0].Width = 320
+	probe.Streams[0].Height = 180
+
+	ladder, err = hlsLadder(probe, &ffmpeg.VideoPipeline{}, []int{360, 720})
+
+	assert.NoError(t, err)
+	assert.Len(t, ladder, 1)
+	assert.Equal(t, "180p", ladder[0].Name)
+	assert.Equal(t, 320, ladder[0].Width)
+	assert.Equal(t, 0, ladder[0].AudioBitrate)
+
+	_, err = hlsLadder(&ffmpeg.ProbeResult{}, &ffmpeg.VideoPipeline{}, []int{360})
+	assert.Error(t, err)
+}
+
+func TestHLSLadder_RotatedSource(t *testing.T) {
+	// Portrait phone footage: coded landscape, displayed rotated by 90.
+	probe := videoOnlyProbe()
+	probe.Streams[0].Width = 1920
+	probe.Streams[0].Height = 1080
+	pipeline := &ffmpeg.VideoPipeline{Rotation: 90}
+
+	ladder, err := hlsLadder(probe, pipeline, []int{360, 720, 1080})
+
+	assert.NoError(t, err)
+	assert.Len(t, ladder, 3)
+	assert.Equal(t, "360p", ladder[0].Name)
+	assert.Equal(t, 202, ladder[0].Width)
+	assert.Equal(t, "1080p", ladder[2].Name)
+	assert.Equal(t, 608, ladder[2].Width)
+	assert.Same(t, pipeline, ladder[2].Video)
+
+	// The selected stream is used rather than the first video stream.
+	probe.Streams = append(probe.Streams, ffmpeg.Stream{Index: 1, CodecType: "video", Width: 640, Height: 480})
+
+	ladder, err = hlsLadder(probe, &ffmpeg.VideoPipeline{StreamIndex: 1}, []int{360, 720})
+
+	assert.NoError(t, err)
+	assert.Len(t, ladder, 1)
+	assert.Equal(t, 480, ladder[0].Width)
+	assert.Equal(t, 1, ladder[0].Video.StreamIndex)
+}
+
+func TestMasterPlaylist(t *testing.T) {
+	probe := videoOnlyProbe()
+	probe.Streams[0].Width = 1280
+	probe.Streams[0].Height = 720
+
+	ladder, err := hlsLadder(probe, &ffmpeg.VideoPipeline{}, []int{360, 720})
+	assert.NoError(t, err)
+
+	expected := "#EXTM3U\n#EXT-X-VERSION:3\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=800000,RESOLUTION=640x360\n360p/index.m3u8\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=2800000,RESOLUTION=1280x720\n720p/index.m3u8\n"
+	assert.Equal(t, expected, masterPlaylist(ladder))
+}
+
+func TestBuildManifest(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFrames(t, dir, 3)
+
+	frames, err := listFrames(dir, 1)
+	assert.NoError(t, err)
+
+	probe := videoOnlyProbe()
+	probe.StartTime = 0.5
+	probe.Streams[0].FrameRate = 30000.0 / 1001.0
+
+	videoID := uuid.New()
+	m, err := buildManifest(videoID, frames, 1, probe)
+
+	assert.NoError(t, err)
+	assert.Equal(t, videoID.String(), m.VideoID)
+	assert.Len(t, m.Frames, 3)
+	assert.Equal(t, "frame_0002.jpg", m.Frames[1].Filename)
+	assert.Equal(t, 0.5, m.Frames[0].PTS)
+	assert.Equal(t, "00:00:00:15", m.Frames[0].Timecode)
+	assert.Equal(t, 1.5, m.Frames[1].PTS)
+	assert.Equal(t, "00:00:01:15", m.Frames[1].Timecode)
+	assert.Greater(t, m.Frames[1].Size, int64(0))
+	assert.True(t, strings.HasPrefix(m.Frames[1].Checksum, "sha256:"))
+}
+
+func TestFormatTimecode(t *testing.T) {
+	assert.Equal(t, "00:00:00:00", formatTimecode(0, 25))
+	assert.Equal(t, "00:00:01:12", formatTimecode(1.5, 25))
+	assert.Equal(t, "01:01:01:29", formatTimecode(3661+29.0/30, 30))
+}
+
+func TestLanguageTag(t *testing.T) {
+	assert.Equal(t, "eng", languageTag("eng"))
+	assert.Equal(t, "pt-BR", languageTag("pt-BR"))
+	assert.Equal(t, "und", languageTag(""))
+	assert.Equal(t, "und", languageTag("../etc"))
+}
+
+func TestBuildThumbnailsVTT(t *testing.T) {
+	frames := make([]frame, 0, 101)
+	for i := 1; i <= 101; i++ {
+		frames = append(frames, frame{Index: i, Filename: fmt.Sprintf("frame_%04d.jpg", i), PTS: float64(i - 1)})
+	}
+
+	vtt := buildThumbnailsVTT(frames, 1, []string{"sprite_001.jpg", "sprite_002.jpg"})
+
+	assert.True(t, strings.HasPrefix(vtt, "WEBVTT\n"))
+	assert.Contains(t, vtt, "00:00:00.000 --> 00:00:01.000\nsprite_001.jpg#xywh=0,0,160,90\n")
+	assert.Contains(t, vtt, "00:00:11.000 --> 00:00:12.000\nsprite_001.jpg#xywh=160,90,160,90\n")
+	assert.Contains(t, vtt, "00:01:40.000 --> 00:01:41.000\nsprite_002.jpg#xywh=0,0,160,90\n")
+}
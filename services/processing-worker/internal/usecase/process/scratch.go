@@ -0,0 +1,67 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/disk"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+)
+
+// jpegBytesPerPixel overestimates the size of an extracted JPEG frame.
+const jpegBytesPerPixel = 0.5
+
+// withTimeout bounds ctx by the deadline of a job on a source of the given
+// duration, in seconds, or returns it unchanged when jobs are not limited.
+func (l JobLimits) withTimeout(ctx context.Context, duration float64) (context.Context, context.CancelFunc) {
+	if l.TimeoutBase <= 0 {
+		return ctx, func() {}
+	}
+	timeout := l.TimeoutBase + time.Duration(duration*l.TimeoutFactor*float64(time.Second))
+	return context.WithTimeout(ctx, timeout)
+}
+
+// ensureScratchSpace fails when the scratch filesystem cannot take needed
+// more bytes and still keep MinFreeSpace free. Platforms without a free
+// space query skip the check.
+func (l JobLimits) ensureScratchSpace(dir string, needed uint64) error {
+	available, err := disk.Available(dir)
+	if errors.Is(err, errors.ErrUnsupported) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check scratch space: %w", err)
+	}
+
+	if required := needed + l.MinFreeSpace; available < required {
+		return fmt.Errorf("insufficient scratch space: %d MB needed, %d MB available", required>>20, available>>20)
+	}
+	return nil
+}
+
+// estimateOutput sizes what a job writes next to its source: the extracted
+// frames and, when requested, the HLS ladder.
+func estimateOutput(probe *ffmpeg.ProbeResult, pipeline *ffmpeg.VideoPipeline, opts commands.ProcessingOptions) uint64 {
+	var pixels float64
+	for _, stream := range probe.Streams {
+		if stream.Index == pipeline.StreamIndex {
+			pixels = float64(stream.Width * stream.Height)
+		}
+	}
+
+	frames := math.Ceil(probe.Duration * extractionFPS)
+	size := frames * pixels * jpegBytesPerPixel
+
+	if opts.HLS.Enabled {
+		for _, height := range opts.HLS.WithDefaults().Renditions {
+			kbps := hlsVideoBitrates[height] + hlsAudioBitrate
+			size += probe.Duration * float64(kbps) * 1000 / 8
+		}
+	}
+
+	return uint64(size)
+}
@@ -0,0 +1,97 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+// detectShots splits the video at the frames ffmpeg scores as scene changes
+// and persists the resulting shots together with the source frame rate,
+// which the EDL export needs for its timecodes.
+func (uc *processUseCaseImpl) detectShots(ctx context.Context, videoID uuid.UUID, videoPath string, frames []frame, probe *ffmpeg.ProbeResult, opts commands.ShotOptions) error {
+	opts = opts.WithDefaults()
+
+	cuts, err := uc.ffmpegService.DetectScenes(ctx, videoPath, *opts.Threshold)
+	if err != nil {
+		return err
+	}
+
+	shots := buildShots(videoID, cuts, frames, probe)
+	if err := uc.shotRepo.ReplaceByVideoID(ctx, videoID, shots); err != nil {
+		return fmt.Errorf("failed to save shots: %w", err)
+	}
+
+	if stream := probe.VideoStream(); stream != nil && stream.FrameRate > 0 {
+		if err := uc.videoRepo.UpdateFrameRate(ctx, videoID, stream.FrameRate); err != nil {
+			return fmt.Errorf("failed to update frame rate: %w", err)
+		}
+	}
+
+	logging.Info("Detected shots", "video_id", videoID, "shots", len(shots))
+	return nil
+}
+
+// buildShots turns the scene change timestamps into contiguous shots that
+// cover the whole video. Each shot is represented by the uploaded frame
+// closest to its midpoint, if any frame survived filtering inside it.
+func buildShots(videoID uuid.UUID, cuts []float64, frames []frame, probe *ffmpeg.ProbeResult) []*entities.Shot {
+	duration := probe.Duration
+	if duration <= 0 && len(frames) > 0 {
+		duration = frames[len(frames)-1].PTS + 1/float64(extractionFPS)
+	}
+
+	boundaries := []float64{0}
+	for _, cut := range cuts {
+		t := roundMillis(cut - probe.StartTime)
+		if t > boundaries[len(boundaries)-1] && t < duration {
+			boundaries = append(boundaries, t)
+		}
+	}
+	boundaries = append(boundaries, roundMillis(duration))
+
+	shots := make([]*entities.Shot, 0, len(boundaries)-1)
+	for i := 0; i+1 < len(boundaries); i++ {
+		shot := &entities.Shot{
+			VideoID:   videoID,
+			Index:     i + 1,
+			StartTime: boundaries[i],
+			EndTime:   boundaries[i+1],
+		}
+
+		if f := representativeFrame(frames, shot.StartTime, shot.EndTime); f != nil {
+			key := fmt.Sprintf("processed/%s/frames/%s", videoID, f.Filename)
+			shot.FramePath = &key
+		}
+
+		shots = append(shots, shot)
+	}
+
+	return shots
+}
+
+func representativeFrame(frames []frame, start, end float64) *frame {
+	mid := (start + end) / 2
+
+	var best *frame
+	for i := range frames {
+		f := &frames[i]
+		if f.PTS < start || f.PTS >= end {
+			continue
+		}
+		if best == nil || math.Abs(f.PTS-mid) < math.Abs(best.PTS-mid) {
+			best = f
+		}
+	}
+	return best
+}
+
+func roundMillis(seconds float64) float64 {
+	return math.Round(seconds*1000) / 1000
+}
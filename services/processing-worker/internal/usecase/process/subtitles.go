@@ -0,0 +1,67 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/shared/pkg/logging"
+)
+
+var languageTagPattern = regexp.MustCompile(`^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$`)
+
+// extractSubtitles converts every text subtitle stream to both SRT and
+// WebVTT, uploads them and returns the resulting tracks.
+func (uc *processUseCaseImpl) extractSubtitles(ctx context.Context, videoID uuid.UUID, videoPath, workDir string, probe *ffmpeg.ProbeResult) ([]*entities.SubtitleTrack, error) {
+	var tracks []*entities.SubtitleTrack
+
+	for _, stream := range probe.StreamsOfType("subtitle") {
+		if !stream.IsTextSubtitle() {
+			logging.Info("Skipping non-text subtitle stream", "video_id", videoID, "stream_index", stream.Index, "codec", stream.CodecName)
+			continue
+		}
+
+		track := &entities.SubtitleTrack{
+			VideoID:     videoID,
+			StreamIndex: stream.Index,
+			Language:    languageTag(stream.Language),
+			Codec:       stream.CodecName,
+		}
+
+		base := fmt.Sprintf("track_%d_%s", stream.Index, track.Language)
+		for _, format := range []string{"srt", "vtt"} {
+			localPath := filepath.Join(workDir, base+"."+format)
+			if err := uc.ffmpegService.ExtractSubtitle(ctx, videoPath, localPath, stream.Index, format); err != nil {
+				return nil, err
+			}
+
+			s3Key := fmt.Sprintf("processed/%s/subtitles/%s.%s", videoID, base, format)
+			if err := uc.uploadFile(ctx, localPath, s3Key); err != nil {
+				return nil, err
+			}
+
+			if format == "srt" {
+				track.SRTPath = s3Key
+			} else {
+				track.VTTPath = s3Key
+			}
+		}
+
+		tracks = append(tracks, track)
+	}
+
+	return tracks, nil
+}
+
+// languageTag normalises the stream language tag, falling back to the
+// "undetermined" code when it is missing or malformed.
+func languageTag(language string) string {
+	if !languageTagPattern.MatchString(language) {
+		return "und"
+	}
+	return language
+}
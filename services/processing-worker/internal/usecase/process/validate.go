@@ -0,0 +1,66 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+)
+
+// sourceError rejects a source the worker cannot or will not process. Its
+// code is stored with the failure so clients can tell the reasons apart.
+type sourceError struct {
+	code    string
+	message string
+}
+
+func (e *sourceError) Error() string {
+	return e.message
+}
+
+// validateSource checks the probed source against the limits before any
+// decoding work starts. streamIndex is the video stream that will be used.
+func validateSource(probe *ffmpeg.ProbeResult, streamIndex int, limits SourceLimits) error {
+	var stream *ffmpeg.Stream
+	for i := range probe.Streams {
+		if probe.Streams[i].Index == streamIndex {
+			stream = &probe.Streams[i]
+		}
+	}
+	if stream == nil || stream.CodecType != "video" {
+		return &sourceError{code: entities.ErrorCodeNoVideoStream, message: ffmpeg.ErrNoVideoStream.Error()}
+	}
+
+	if limits.MaxDuration > 0 && probe.Duration > limits.MaxDuration.Seconds() {
+		return &sourceError{
+			code:    entities.ErrorCodeDurationExceeded,
+			message: fmt.Sprintf("video duration %.0fs exceeds the %s limit", probe.Duration, limits.MaxDuration),
+		}
+	}
+
+	if limits.MaxDimension > 0 && (stream.Width > limits.MaxDimension || stream.Height > limits.MaxDimension) {
+		return &sourceError{
+			code:    entities.ErrorCodeResolutionExceeded,
+			message: fmt.Sprintf("video resolution %dx%d exceeds the %dpx limit", stream.Width, stream.Height, limits.MaxDimension),
+		}
+	}
+
+	if len(limits.AllowedCodecs) > 0 && !containsCodec(limits.AllowedCodecs, stream.CodecName) {
+		return &sourceError{
+			code:    entities.ErrorCodeUnsupportedCodec,
+			message: fmt.Sprintf("video codec %q is not supported", stream.CodecName),
+		}
+	}
+
+	return nil
+}
+
+func containsCodec(codecs []string, name string) bool {
+	for _, codec := range codecs {
+		if strings.EqualFold(codec, name) {
+			return true
+		}
+	}
+	return false
+}
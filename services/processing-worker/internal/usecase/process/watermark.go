@@ -0,0 +1,57 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+)
+
+// watermarkAssetKey is where the gateway stores a user's watermark images,
+// in the uploads bucket.
+func watermarkAssetKey(userID int64, assetID string) string {
+	return fmt.Sprintf("assets/%d/watermarks/%s.png", userID, assetID)
+}
+
+// prepareOverlay resolves the watermark options into an ffmpeg overlay,
+// downloading the watermark image into workDir. It returns nil when no
+// watermark was requested.
+func (uc *processUseCaseImpl) prepareOverlay(ctx context.Context, cmd commands.ProcessCommand, workDir string) (*ffmpeg.Overlay, error) {
+	opts := cmd.Options.Watermark
+	if !opts.Enabled() {
+		return nil, nil
+	}
+	opts = opts.WithDefaults()
+
+	overlay := &ffmpeg.Overlay{
+		Text:     opts.Text,
+		Filename: cmd.Filename,
+		Position: opts.Position,
+		Opacity:  *opts.Opacity,
+		FontSize: opts.FontSize,
+	}
+
+	if opts.AssetID != "" {
+		reader, err := uc.s3Client.GetObject(ctx, "", watermarkAssetKey(cmd.UserID, opts.AssetID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to download watermark: %w", err)
+		}
+		defer reader.Close()
+
+		overlay.ImagePath = filepath.Join(workDir, "watermark.png")
+		file, err := os.Create(overlay.ImagePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create watermark file: %w", err)
+		}
+		defer file.Close()
+
+		if _, err := file.ReadFrom(reader); err != nil {
+			return nil, fmt.Errorf("failed to write watermark file: %w", err)
+		}
+	}
+
+	return overlay, nil
+}
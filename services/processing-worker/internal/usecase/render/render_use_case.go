@@ -0,0 +1,11 @@
+package render
+
+import (
+	"context"
+
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+)
+
+type RenderUseCase interface {
+	Execute(ctx context.Context, cmd commands.RenderCommand) error
+}
@@ -0,0 +1,190 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/domain/repositories"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const inputPattern = "input_%05d.jpg"
+
+type renderUseCaseImpl struct {
+	renderRepo      repositories.RenderRepository
+	s3Client        s3.S3Client
+	ffmpegService   ffmpeg.FFmpegService
+	processedBucket string
+	scratchDir      string
+}
+
+func NewRenderUseCase(
+	renderRepo repositories.RenderRepository,
+	s3Client s3.S3Client,
+	ffmpegService ffmpeg.FFmpegService,
+	processedBucket string,
+	scratchDir string,
+) RenderUseCase {
+	return &renderUseCaseImpl{
+		renderRepo:      renderRepo,
+		s3Client:        s3Client,
+		ffmpegService:   ffmpegService,
+		processedBucket: processedBucket,
+		scratchDir:      scratchDir,
+	}
+}
+
+func (uc *renderUseCaseImpl) Execute(ctx context.Context, cmd commands.RenderCommand) error {
+	logging.Info("Starting render", "render_id", cmd.RenderID, "video_id", cmd.VideoID)
+
+	if err := uc.renderRepo.MarkAsStarted(ctx, cmd.RenderID); err != nil {
+		return fmt.Errorf("failed to mark render as started: %w", err)
+	}
+
+	keys, err := uc.frameKeys(ctx, cmd)
+	if err != nil {
+		return uc.handleError(ctx, cmd.RenderID, err)
+	}
+
+	tmpDir, err := os.MkdirTemp(uc.scratchDir, "video-render-*")
+	if err != nil {
+		return uc.handleError(ctx, cmd.RenderID, fmt.Errorf("failed to create temp dir: %w", err))
+	}
+	defer os.RemoveAll(tmpDir)
+
+	logging.Info("Downloading frames", "render_id", cmd.RenderID, "count", len(keys))
+	if err := uc.downloadFrames(ctx, keys, tmpDir); err != nil {
+		return uc.handleError(ctx, cmd.RenderID, fmt.Errorf("failed to download frames: %w", err))
+	}
+
+	outputPath := filepath.Join(tmpDir, "render."+cmd.Format)
+	err = uc.ffmpegService.EncodeImageSequence(ctx, filepath.Join(tmpDir, inputPattern), outputPath, ffmpeg.EncodeOptions{
+		Format: cmd.Format,
+		FPS:    cmd.FPS,
+		Width:  cmd.Width,
+		Height: cmd.Height,
+	})
+	if err != nil {
+		return uc.handleError(ctx, cmd.RenderID, fmt.Errorf("failed to encode render: %w", err))
+	}
+
+	s3Key := fmt.Sprintf("processed/%s/renders/%s.%s", cmd.VideoID, cmd.RenderID, cmd.Format)
+	if err := uc.uploadFile(ctx, outputPath, s3Key); err != nil {
+		return uc.handleError(ctx, cmd.RenderID, fmt.Errorf("failed to upload render: %w", err))
+	}
+
+	if err := uc.renderRepo.UpdateRenderComplete(ctx, cmd.RenderID, len(keys), s3Key); err != nil {
+		return uc.handleError(ctx, cmd.RenderID, fmt.Errorf("failed to update completion: %w", err))
+	}
+
+	logging.Info("Render completed", "render_id", cmd.RenderID, "frame_count", len(keys))
+	return nil
+}
+
+// frameKeys resolves the requested frame indexes to the uploaded frames, in
+// the requested order. Frames dropped by the frame filters cannot be used.
+func (uc *renderUseCaseImpl) frameKeys(ctx context.Context, cmd commands.RenderCommand) ([]string, error) {
+	prefix := fmt.Sprintf("processed/%s/frames/", cmd.VideoID)
+	uploaded, err := uc.s3Client.ListObjects(ctx, uc.processedBucket, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list frames: %w", err)
+	}
+
+	if len(cmd.Frames) == 0 {
+		sort.Strings(uploaded)
+		if len(uploaded) == 0 {
+			return nil, errors.New("no frames to render")
+		}
+		return uploaded, nil
+	}
+
+	byName := make(map[string]string, len(uploaded))
+	for _, key := range uploaded {
+		byName[path.Base(key)] = key
+	}
+
+	keys := make([]string, len(cmd.Frames))
+	for i, index := range cmd.Frames {
+		key, ok := byName[fmt.Sprintf("frame_%04d.jpg", index)]
+		if !ok {
+			return nil, fmt.Errorf("frame %d not found", index)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// downloadFrames writes the frames as a numbered sequence for ffmpeg. A frame
+// used more than once is downloaded once and linked.
+func (uc *renderUseCaseImpl) downloadFrames(ctx context.Context, keys []string, dir string) error {
+	downloaded := make(map[string]string)
+
+	for i, key := range keys {
+		target := filepath.Join(dir, fmt.Sprintf(inputPattern, i+1))
+
+		if existing, ok := downloaded[key]; ok {
+			if err := os.Link(existing, target); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := uc.downloadFile(ctx, key, target); err != nil {
+			return err
+		}
+		downloaded[key] = target
+	}
+
+	return nil
+}
+
+func (uc *renderUseCaseImpl) downloadFile(ctx context.Context, s3Key, path string) error {
+	reader, err := uc.s3Client.GetObject(ctx, uc.processedBucket, s3Key)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", s3Key, err)
+	}
+	defer reader.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, reader); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+func (uc *renderUseCaseImpl) uploadFile(ctx context.Context, path, s3Key string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
+	}
+	defer file.Close()
+
+	return uc.s3Client.Upload(ctx, uc.processedBucket, s3Key, file)
+}
+
+func (uc *renderUseCaseImpl) handleError(ctx context.Context, renderID uuid.UUID, err error) error {
+	logging.Error("Render failed", "render_id", renderID, "error", err)
+
+	errMsg := err.Error()
+	if updateErr := uc.renderRepo.UpdateStatus(ctx, renderID, entities.StatusFailed, &errMsg); updateErr != nil {
+		logging.Error("Failed to update render error status", "error", updateErr)
+	}
+
+	return err
+}
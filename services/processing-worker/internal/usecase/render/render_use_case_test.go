@@ -0,0 +1,317 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/processing-worker/internal/domain/entities"
+	"github.com/video-platform/services/processing-worker/internal/infrastructure/ffmpeg"
+	"github.com/video-platform/services/processing-worker/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockRenderRepository struct {
+	mock.Mock
+}
+
+func (m *MockRenderRepository) MarkAsStarted(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRenderRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.VideoStatus, errorMsg *string) error {
+	args := m.Called(ctx, id, status, errorMsg)
+	return args.Error(0)
+}
+
+func (m *MockRenderRepository) UpdateRenderComplete(ctx context.Context, id uuid.UUID, frameCount int, outputPath string) error {
+	args := m.Called(ctx, id, frameCount, outputPath)
+	return args.Error(0)
+}
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+// Mock FFmpegService
+type MockFFmpegService struct {
+	mock.Mock
+}
+
+func (m *MockFFmpegService) ExtractFrames(ctx context.Context, videoPath, outputDir string, opts ffmpeg.ExtractOptions) (int, error) {
+	args := m.Called(ctx, videoPath, outputDir, opts)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockFFmpegService) GeneratePreview(ctx context.Context, videoPath, outputPath string, opts ffmpeg.PreviewOptions) error {
+	args := m.Called(ctx, videoPath, outputPath, opts)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) ExtractAudio(ctx context.Context, videoPath, outputPath, format string) error {
+	args := m.Called(ctx, videoPath, outputPath, format)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) ExtractSubtitle(ctx context.Context, videoPath, outputPath string, streamIndex int, format string) error {
+	args := m.Called(ctx, videoPath, outputPath, streamIndex, format)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) Probe(ctx context.Context, videoPath string) (*ffmpeg.ProbeResult, error) {
+	args := m.Called(ctx, videoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ffmpeg.ProbeResult), args.Error(1)
+}
+
+func (m *MockFFmpegService) DetectScenes(ctx context.Context, videoPath string, threshold float64) ([]float64, error) {
+	args := m.Called(ctx, videoPath, threshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]float64), args.Error(1)
+}
+
+func (m *MockFFmpegService) EncodeImageSequence(ctx context.Context, inputPattern, outputPath string, opts ffmpeg.EncodeOptions) error {
+	args := m.Called(ctx, inputPattern, outputPath, opts)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) Keyframes(ctx context.Context, videoPath string) ([]float64, error) {
+	args := m.Called(ctx, videoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]float64), args.Error(1)
+}
+
+func (m *MockFFmpegService) CutClip(ctx context.Context, videoPath, outputPath string, start, end float64, streamCopy bool) error {
+	args := m.Called(ctx, videoPath, outputPath, start, end, streamCopy)
+	return args.Error(0)
+}
+
+func (m *MockFFmpegService) TranscodeHLS(ctx context.Context, videoPath, outputDir string, rendition ffmpeg.HLSRendition) error {
+	args := m.Called(ctx, videoPath, outputDir, rendition)
+	return args.Error(0)
+}
+
+func TestRenderUseCase_Execute_OrderedFrames(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockRenderRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+
+	renderID := uuid.New()
+	videoID := uuid.New()
+	cmd := commands.RenderCommand{
+		RenderID: renderID,
+		VideoID:  videoID,
+		Frames:   []int{3, 1, 3},
+		Format:   "webm",
+		FPS:      2,
+		Width:    320,
+	}
+
+	prefix := "processed/" + videoID.String() + "/frames/"
+	outputKey := "processed/" + videoID.String() + "/renders/" + renderID.String() + ".webm"
+
+	mockRepo.On("MarkAsStarted", ctx, renderID).Return(nil)
+	mockS3.On("ListObjects", ctx, "processed-bucket", prefix).
+		Return([]string{prefix + "frame_0001.jpg", prefix + "frame_0002.jpg", prefix + "frame_0003.jpg"}, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", prefix+"frame_0003.jpg").
+		Return(io.NopCloser(strings.NewReader("third")), nil).Once()
+	mockS3.On("GetObject", ctx, "processed-bucket", prefix+"frame_0001.jpg").
+		Return(io.NopCloser(strings.NewReader("first")), nil).Once()
+
+	var inputs []string
+	mockFFmpeg.On("EncodeImageSequence", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"),
+		ffmpeg.EncodeOptions{Format: "webm", FPS: 2, Width: 320}).
+		Run(func(args mock.Arguments) {
+			dir := filepath.Dir(args.String(1))
+			for i := 1; i <= 3; i++ {
+				data, _ := os.ReadFile(filepath.Join(dir, fmt.Sprintf("input_%05d.jpg", i)))
+				inputs = append(inputs, string(data))
+			}
+			os.WriteFile(args.String(2), []byte("video"), 0644)
+		}).
+		Return(nil)
+	mockS3.On("Upload", ctx, "processed-bucket", outputKey, mock.Anything).Return(nil)
+	mockRepo.On("UpdateRenderComplete", ctx, renderID, 3, outputKey).Return(nil)
+
+	useCase := NewRenderUseCase(mockRepo, mockS3, mockFFmpeg, "processed-bucket", "")
+
+	// Act
+	err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"third", "first", "third"}, inputs)
+
+	mockRepo.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+	mockFFmpeg.AssertExpectations(t)
+}
+
+func TestRenderUseCase_Execute_MissingFrame(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockRenderRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+
+	renderID := uuid.New()
+	videoID := uuid.New()
+	cmd := commands.RenderCommand{
+		RenderID: renderID,
+		VideoID:  videoID,
+		Frames:   []int{1, 7},
+		Format:   "mp4",
+		FPS:      24,
+	}
+
+	prefix := "processed/" + videoID.String() + "/frames/"
+
+	mockRepo.On("MarkAsStarted", ctx, renderID).Return(nil)
+	mockS3.On("ListObjects", ctx, "processed-bucket", prefix).Return([]string{prefix + "frame_0001.jpg"}, nil)
+	mockRepo.On("UpdateStatus", ctx, renderID, entities.StatusFailed, mock.MatchedBy(func(msg *string) bool {
+		return *msg == "frame 7 not found"
+	})).Return(nil)
+
+	useCase := NewRenderUseCase(mockRepo, mockS3, mockFFmpeg, "processed-bucket", "")
+
+	// Act
+	err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.EqualError(t, err, "frame 7 not found")
+	mockRepo.AssertExpectations(t)
+	mockFFmpeg.AssertNotCalled(t, "EncodeImageSequence", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRenderUseCase_Execute_EncodeError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := new(MockRenderRepository)
+	mockS3 := new(MockS3Client)
+	mockFFmpeg := new(MockFFmpegService)
+
+	renderID := uuid.New()
+	videoID := uuid.New()
+	cmd := commands.RenderCommand{
+		RenderID: renderID,
+		VideoID:  videoID,
+		Format:   "mp4",
+		FPS:      24,
+	}
+
+	prefix := "processed/" + videoID.String() + "/frames/"
+
+	mockRepo.On("MarkAsStarted", ctx, renderID).Return(nil)
+	mockS3.On("ListObjects", ctx, "processed-bucket", prefix).Return([]string{prefix + "frame_0001.jpg"}, nil)
+	mockS3.On("GetObject", ctx, "processed-bucket", prefix+"frame_0001.jpg").
+		Return(io.NopCloser(strings.NewReader("first")), nil)
+	mockFFmpeg.On("EncodeImageSequence", ctx, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("encoder missing"))
+	mockRepo.On("UpdateStatus", ctx, renderID, entities.StatusFailed, mock.Anything).Return(nil)
+
+	useCase := NewRenderUseCase(mockRepo, mockS3, mockFFmpeg, "processed-bucket", "")
+
+	// Act
+	err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to encode render")
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/video-platform/services/storage/internal/controller"
 	"github.com/video-platform/services/storage/internal/infrastructure/api"
 	"github.com/video-platform/services/storage/internal/usecase/createzip"
+	"github.com/video-platform/services/storage/internal/usecase/transform"
 	"github.com/video-platform/shared/pkg/config"
 	"github.com/video-platform/shared/pkg/storage/s3"
 )
@@ -27,10 +28,13 @@ func InitializeApp() *fx.App {
 			},
 
 			fx.Annotate(createzip.NewCreateZipUseCase, fx.As(new(createzip.CreateZipUseCase))),
+			fx.Annotate(transform.NewTransformFrameUseCase, fx.As(new(transform.TransformFrameUseCase))),
 			fx.Annotate(controller.NewStorageController, fx.As(new(controller.StorageController))),
 
 			chi.NewRouter,
-			api.NewStorageHTTPController,
+			func(ctrl controller.StorageController, cfg *config.Config) *api.StorageHTTPController {
+				return api.NewStorageHTTPController(ctrl, cfg.TransformSigningKey)
+			},
 		),
 		fx.Invoke(registerRoutes),
 		fx.Invoke(startHTTPServer),
@@ -5,22 +5,33 @@ import (
 
 	"github.com/video-platform/services/storage/internal/usecase/commands"
 	"github.com/video-platform/services/storage/internal/usecase/createzip"
+	"github.com/video-platform/services/storage/internal/usecase/transform"
 )
 
 type StorageController interface {
 	CreateZip(ctx context.Context, cmd commands.CreateZipCommand) (*createzip.CreateZipOutput, error)
+	TransformFrame(ctx context.Context, cmd commands.TransformFrameCommand) (*transform.TransformFrameOutput, error)
 }
 
 type storageControllerImpl struct {
-	createZipUseCase createzip.CreateZipUseCase
+	createZipUseCase      createzip.CreateZipUseCase
+	transformFrameUseCase transform.TransformFrameUseCase
 }
 
-func NewStorageController(createZipUseCase createzip.CreateZipUseCase) StorageController {
+func NewStorageController(
+	createZipUseCase createzip.CreateZipUseCase,
+	transformFrameUseCase transform.TransformFrameUseCase,
+) StorageController {
 	return &storageControllerImpl{
-		createZipUseCase: createZipUseCase,
+		createZipUseCase:      createZipUseCase,
+		transformFrameUseCase: transformFrameUseCase,
 	}
 }
 
 func (c *storageControllerImpl) CreateZip(ctx context.Context, cmd commands.CreateZipCommand) (*createzip.CreateZipOutput, error) {
 	return c.createZipUseCase.Execute(ctx, cmd)
 }
+
+func (c *storageControllerImpl) TransformFrame(ctx context.Context, cmd commands.TransformFrameCommand) (*transform.TransformFrameOutput, error) {
+	return c.transformFrameUseCase.Execute(ctx, cmd)
+}
@@ -3,31 +3,38 @@ package api
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/video-platform/services/storage/internal/controller"
 	"github.com/video-platform/services/storage/internal/usecase/commands"
 	"github.com/video-platform/shared/pkg/rest"
+	"github.com/video-platform/shared/pkg/urlsign"
 )
 
 type CreateZipRequest struct {
-	VideoID   string `json:"video_id"`
-	S3Prefix  string `json:"s3_prefix"`
-	OutputKey string `json:"output_key"`
+	VideoID   string   `json:"video_id"`
+	S3Prefix  string   `json:"s3_prefix"`
+	OutputKey string   `json:"output_key"`
+	ExtraKeys []string `json:"extra_keys"`
 }
 
 type StorageHTTPController struct {
 	controller controller.StorageController
+	signingKey string
 }
 
-func NewStorageHTTPController(controller controller.StorageController) *StorageHTTPController {
+func NewStorageHTTPController(controller controller.StorageController, signingKey string) *StorageHTTPController {
 	return &StorageHTTPController{
 		controller: controller,
+		signingKey: signingKey,
 	}
 }
 
 func (h *StorageHTTPController) RegisterRoutes(r chi.Router) {
 	r.Post("/internal/zip/create", h.CreateZip)
+	r.Get("/frames/{videoID}/{filename}", h.TransformFrame)
 }
 
 func (h *StorageHTTPController) CreateZip(w http.ResponseWriter, r *http.Request) {
@@ -41,6 +48,7 @@ func (h *StorageHTTPController) CreateZip(w http.ResponseWriter, r *http.Request
 		VideoID:   req.VideoID,
 		S3Prefix:  req.S3Prefix,
 		OutputKey: req.OutputKey,
+		ExtraKeys: req.ExtraKeys,
 	}
 
 	output, err := h.controller.CreateZip(r.Context(), cmd)
@@ -51,3 +59,66 @@ func (h *StorageHTTPController) CreateZip(w http.ResponseWriter, r *http.Request
 
 	rest.RespondSuccess(w, output)
 }
+
+// TransformFrame is publicly reachable, so every request must carry a
+// signature issued by the API gateway.
+func (h *StorageHTTPController) TransformFrame(w http.ResponseWriter, r *http.Request) {
+	if h.signingKey == "" {
+		rest.RespondError(w, http.StatusForbidden, "FORBIDDEN", "frame transforms are not enabled")
+		return
+	}
+
+	videoID := chi.URLParam(r, "videoID")
+	filename := chi.URLParam(r, "filename")
+
+	query := r.URL.Query()
+	if err := urlsign.Verify(h.signingKey, urlsign.FramePath(videoID, filename), query, time.Now()); err != nil {
+		rest.RespondError(w, http.StatusForbidden, "FORBIDDEN", err.Error())
+		return
+	}
+
+	width, err := optionalInt(query.Get("width"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid width")
+		return
+	}
+	height, err := optionalInt(query.Get("height"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid height")
+		return
+	}
+	quality, err := optionalInt(query.Get("quality"))
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid quality")
+		return
+	}
+
+	cmd := commands.TransformFrameCommand{
+		VideoID:  videoID,
+		Filename: filename,
+		Width:    width,
+		Height:   height,
+		Fit:      query.Get("fit"),
+		Format:   query.Get("format"),
+		Quality:  quality,
+	}
+
+	output, err := h.controller.TransformFrame(r.Context(), cmd)
+	if err != nil {
+		rest.RespondError(w, http.StatusBadRequest, "TRANSFORM_FAILED", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", output.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(output.Image)))
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.WriteHeader(http.StatusOK)
+	w.Write(output.Image)
+}
+
+func optionalInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
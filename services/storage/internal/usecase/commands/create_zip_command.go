@@ -4,4 +4,5 @@ type CreateZipCommand struct {
 	VideoID   string
 	S3Prefix  string
 	OutputKey string
+	ExtraKeys []string
 }
@@ -0,0 +1,11 @@
+package commands
+
+type TransformFrameCommand struct {
+	VideoID  string
+	Filename string
+	Width    int
+	Height   int
+	Fit      string
+	Format   string
+	Quality  int
+}
@@ -6,13 +6,20 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/video-platform/services/storage/internal/usecase/commands"
 	"github.com/video-platform/shared/pkg/logging"
 	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
+var manifestFiles = map[string]bool{
+	"manifest.json": true,
+	"manifest.csv":  true,
+}
+
 type createZipUseCaseImpl struct {
 	s3Client s3.S3Client
 }
@@ -37,6 +44,16 @@ func (uc *createZipUseCaseImpl) Execute(ctx context.Context, cmd commands.Create
 
 	logging.Info("Found frames", "count", len(files))
 
+	manifests, err := uc.manifestKeys(ctx, cmd.S3Prefix)
+	if err != nil {
+		return nil, err
+	}
+	files = append(manifests, files...)
+
+	// Extra artifacts (audio, subtitles, ...) live outside the frames prefix
+	// and are placed at the root of the archive alongside the frames.
+	files = append(files, cmd.ExtraKeys...)
+
 	var buf bytes.Buffer
 	zipWriter := zip.NewWriter(&buf)
 
@@ -84,3 +101,22 @@ func (uc *createZipUseCaseImpl) Execute(ctx context.Context, cmd commands.Create
 		ZipSizeBytes: zipSize,
 	}, nil
 }
+
+// manifestKeys finds the frame manifests the worker stores next to the frames
+// prefix (processed/<id>/manifest.*) so they land at the root of the archive.
+func (uc *createZipUseCaseImpl) manifestKeys(ctx context.Context, framesPrefix string) ([]string, error) {
+	parent := path.Dir(strings.TrimSuffix(framesPrefix, "/"))
+
+	keys, err := uc.s3Client.ListObjects(ctx, "", parent+"/manifest.")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list manifests: %w", err)
+	}
+
+	manifests := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if manifestFiles[path.Base(key)] {
+			manifests = append(manifests, key)
+		}
+	}
+	return manifests, nil
+}
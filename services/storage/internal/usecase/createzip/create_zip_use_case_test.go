@@ -1,6 +1,7 @@
 package createzip
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/video-platform/services/storage/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
 )
 
 type MockS3Client struct {
@@ -51,6 +53,16 @@ func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key str
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
 	args := m.Called(ctx, bucket, prefix)
 	if args.Get(0) == nil {
@@ -59,6 +71,34 @@ func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) (
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
 func TestCreateZipUseCase_Execute_Success(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
@@ -77,6 +117,7 @@ func TestCreateZipUseCase_Execute_Success(t *testing.T) {
 	}
 
 	mockS3.On("ListObjects", ctx, "", "processed/video-123/frames/").Return(files, nil)
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/manifest.").Return([]string{}, nil)
 
 	for _, file := range files {
 		content := io.NopCloser(strings.NewReader("fake image data"))
@@ -166,6 +207,7 @@ func TestCreateZipUseCase_Execute_GetObjectError(t *testing.T) {
 	files := []string{"processed/video-123/frames/frame001.jpg"}
 
 	mockS3.On("ListObjects", ctx, "", "processed/video-123/frames/").Return(files, nil)
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/manifest.").Return([]string{}, nil)
 	mockS3.On("GetObject", ctx, "", files[0]).Return(nil, errors.New("S3 get error"))
 
 	useCase := NewCreateZipUseCase(mockS3)
@@ -195,6 +237,7 @@ func TestCreateZipUseCase_Execute_UploadError(t *testing.T) {
 	files := []string{"processed/video-123/frames/frame001.jpg"}
 
 	mockS3.On("ListObjects", ctx, "", "processed/video-123/frames/").Return(files, nil)
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/manifest.").Return([]string{}, nil)
 	content := io.NopCloser(strings.NewReader("fake image data"))
 	mockS3.On("GetObject", ctx, "", files[0]).Return(content, nil)
 	mockS3.On("Upload", ctx, "", "processed/video-123/frames.zip", mock.Anything).Return(errors.New("S3 upload error"))
@@ -230,6 +273,7 @@ func TestCreateZipUseCase_Execute_MultipleFrames(t *testing.T) {
 	}
 
 	mockS3.On("ListObjects", ctx, "", "processed/video-123/frames/").Return(files, nil)
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/manifest.").Return([]string{}, nil)
 
 	for _, file := range files {
 		content := io.NopCloser(bytes.NewReader(make([]byte, 1024))) // 1KB per frame
@@ -251,3 +295,98 @@ func TestCreateZipUseCase_Execute_MultipleFrames(t *testing.T) {
 
 	mockS3.AssertExpectations(t)
 }
+
+func TestCreateZipUseCase_Execute_IncludesExtraKeys(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+
+	cmd := commands.CreateZipCommand{
+		VideoID:   "video-123",
+		S3Prefix:  "processed/video-123/frames/",
+		OutputKey: "processed/video-123/frames.zip",
+		ExtraKeys: []string{"processed/video-123/audio/audio.flac"},
+	}
+
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/frames/").Return([]string{"processed/video-123/frames/frame_0001.jpg"}, nil)
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/manifest.").Return([]string{}, nil)
+	mockS3.On("GetObject", ctx, "", "processed/video-123/frames/frame_0001.jpg").Return(io.NopCloser(strings.NewReader("frame")), nil)
+	mockS3.On("GetObject", ctx, "", "processed/video-123/audio/audio.flac").Return(io.NopCloser(strings.NewReader("audio")), nil)
+
+	var names []string
+	mockS3.On("Upload", ctx, "", "processed/video-123/frames.zip", mock.Anything).
+		Run(func(args mock.Arguments) {
+			data, _ := io.ReadAll(args.Get(3).(io.Reader))
+			reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				t.Fatalf("invalid zip: %v", err)
+			}
+			for _, f := range reader.File {
+				names = append(names, f.Name)
+			}
+		}).
+		Return(nil)
+
+	useCase := NewCreateZipUseCase(mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.FileCount)
+	assert.Equal(t, []string{"frame_0001.jpg", "audio.flac"}, names)
+
+	mockS3.AssertExpectations(t)
+}
+
+func TestCreateZipUseCase_Execute_PlacesManifestAtRoot(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+
+	cmd := commands.CreateZipCommand{
+		VideoID:   "video-123",
+		S3Prefix:  "processed/video-123/frames/",
+		OutputKey: "processed/video-123/frames.zip",
+	}
+
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/frames/").Return([]string{"processed/video-123/frames/frame_0001.jpg"}, nil)
+	mockS3.On("ListObjects", ctx, "", "processed/video-123/manifest.").Return([]string{
+		"processed/video-123/manifest.csv",
+		"processed/video-123/manifest.json",
+	}, nil)
+	for _, key := range []string{
+		"processed/video-123/frames/frame_0001.jpg",
+		"processed/video-123/manifest.csv",
+		"processed/video-123/manifest.json",
+	} {
+		mockS3.On("GetObject", ctx, "", key).Return(io.NopCloser(strings.NewReader("data")), nil)
+	}
+
+	var names []string
+	mockS3.On("Upload", ctx, "", "processed/video-123/frames.zip", mock.Anything).
+		Run(func(args mock.Arguments) {
+			data, _ := io.ReadAll(args.Get(3).(io.Reader))
+			reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				t.Fatalf("invalid zip: %v", err)
+			}
+			for _, f := range reader.File {
+				names = append(names, f.Name)
+			}
+		}).
+		Return(nil)
+
+	useCase := NewCreateZipUseCase(mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.FileCount)
+	assert.Equal(t, []string{"manifest.csv", "manifest.json", "frame_0001.jpg"}, names)
+
+	mockS3.AssertExpectations(t)
+}
@@ -0,0 +1,18 @@
+package transform
+
+import (
+	"context"
+
+	"github.com/video-platform/services/storage/internal/usecase/commands"
+)
+
+type TransformFrameOutput struct {
+	Image       []byte
+	ContentType string
+	CacheKey    string
+	Cached      bool
+}
+
+type TransformFrameUseCase interface {
+	Execute(ctx context.Context, cmd commands.TransformFrameCommand) (*TransformFrameOutput, error)
+}
@@ -0,0 +1,187 @@
+package transform
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"golang.org/x/image/draw"
+
+	"github.com/video-platform/services/storage/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/logging"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+const (
+	FitContain = "contain"
+	FitCover   = "cover"
+	FitFill    = "fill"
+
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+
+	maxDimension   = 4096
+	defaultQuality = 85
+)
+
+var frameFilename = regexp.MustCompile(`^frame_\d+\.jpg$`)
+
+type transformFrameUseCaseImpl struct {
+	s3Client s3.S3Client
+}
+
+func NewTransformFrameUseCase(s3Client s3.S3Client) TransformFrameUseCase {
+	return &transformFrameUseCaseImpl{
+		s3Client: s3Client,
+	}
+}
+
+func (uc *transformFrameUseCaseImpl) Execute(ctx context.Context, cmd commands.TransformFrameCommand) (*TransformFrameOutput, error) {
+	cmd, err := normalize(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := derivativeKey(cmd)
+	contentType := "image/" + cmd.Format
+
+	if cached, err := uc.s3Client.GetObject(ctx, "", cacheKey); err == nil {
+		defer cached.Close()
+		data, err := io.ReadAll(cached)
+		if err == nil {
+			return &TransformFrameOutput{Image: data, ContentType: contentType, CacheKey: cacheKey, Cached: true}, nil
+		}
+	}
+
+	source, err := uc.s3Client.GetObject(ctx, "", fmt.Sprintf("processed/%s/frames/%s", cmd.VideoID, cmd.Filename))
+	if err != nil {
+		return nil, fmt.Errorf("frame not found: %w", err)
+	}
+	defer source.Close()
+
+	img, _, err := image.Decode(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode frame: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := encode(&buf, resize(img, cmd.Width, cmd.Height, cmd.Fit), cmd.Format, cmd.Quality); err != nil {
+		return nil, fmt.Errorf("failed to encode frame: %w", err)
+	}
+	data := buf.Bytes()
+
+	// The cache is best effort: a failed write still serves the derivative.
+	if err := uc.s3Client.Upload(ctx, "", cacheKey, bytes.NewReader(data)); err != nil {
+		logging.Warn("Failed to cache frame derivative", "key", cacheKey, "error", err)
+	}
+
+	return &TransformFrameOutput{Image: data, ContentType: contentType, CacheKey: cacheKey}, nil
+}
+
+// normalize validates the command and fills in defaults so that equivalent
+// requests share a single cache key.
+func normalize(cmd commands.TransformFrameCommand) (commands.TransformFrameCommand, error) {
+	if cmd.VideoID == "" || strings.ContainsAny(cmd.VideoID, "/.") {
+		return cmd, errors.New("invalid video id")
+	}
+	if !frameFilename.MatchString(cmd.Filename) {
+		return cmd, errors.New("invalid frame filename")
+	}
+
+	if cmd.Width < 0 || cmd.Height < 0 || cmd.Width > maxDimension || cmd.Height > maxDimension {
+		return cmd, fmt.Errorf("width and height must be between 0 and %d", maxDimension)
+	}
+
+	switch cmd.Fit {
+	case "":
+		cmd.Fit = FitContain
+	case FitContain, FitCover, FitFill:
+	default:
+		return cmd, errors.New("fit must be contain, cover or fill")
+	}
+
+	switch cmd.Format {
+	case "", "jpg", FormatJPEG:
+		cmd.Format = FormatJPEG
+	case FormatPNG:
+		cmd.Quality = 0
+	default:
+		return cmd, errors.New("format must be jpeg or png")
+	}
+
+	if cmd.Format == FormatJPEG {
+		if cmd.Quality == 0 {
+			cmd.Quality = defaultQuality
+		}
+		if cmd.Quality < 1 || cmd.Quality > 100 {
+			return cmd, errors.New("quality must be between 1 and 100")
+		}
+	}
+
+	return cmd, nil
+}
+
+// derivativeKey places the derivative next to the frames in the processed
+// bucket, keyed by every transform parameter.
+func derivativeKey(cmd commands.TransformFrameCommand) string {
+	name := strings.TrimSuffix(cmd.Filename, path.Ext(cmd.Filename))
+	ext := "jpg"
+	if cmd.Format == FormatPNG {
+		ext = "png"
+	}
+	return fmt.Sprintf("processed/%s/derived/%s_w%d_h%d_%s_q%d.%s",
+		cmd.VideoID, name, cmd.Width, cmd.Height, cmd.Fit, cmd.Quality, ext)
+}
+
+// resize scales img to width x height. A zero dimension follows the aspect
+// ratio; contain fits inside the box, cover fills it and crops the overflow
+// from the center, and fill stretches.
+func resize(img image.Image, width, height int, fit string) image.Image {
+	src := img.Bounds()
+	if width == 0 && height == 0 {
+		return img
+	}
+	if width == 0 {
+		width = max(1, src.Dx()*height/src.Dy())
+	}
+	if height == 0 {
+		height = max(1, src.Dy()*width/src.Dx())
+	}
+
+	switch fit {
+	case FitContain:
+		scaleW := float64(width) / float64(src.Dx())
+		scaleH := float64(height) / float64(src.Dy())
+		scale := min(scaleW, scaleH)
+		width = max(1, int(float64(src.Dx())*scale))
+		height = max(1, int(float64(src.Dy())*scale))
+	case FitCover:
+		scaleW := float64(width) / float64(src.Dx())
+		scaleH := float64(height) / float64(src.Dy())
+		scale := max(scaleW, scaleH)
+		cropW := min(src.Dx(), int(float64(width)/scale))
+		cropH := min(src.Dy(), int(float64(height)/scale))
+		x := src.Min.X + (src.Dx()-cropW)/2
+		y := src.Min.Y + (src.Dy()-cropH)/2
+		src = image.Rect(x, y, x+cropW, y+cropH)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
+
+func encode(w io.Writer, img image.Image, format string, quality int) error {
+	if format == FormatPNG {
+		return png.Encode(w, img)
+	}
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+}
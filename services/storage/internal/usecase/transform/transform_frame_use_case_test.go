@@ -0,0 +1,241 @@
+package transform
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/video-platform/services/storage/internal/usecase/commands"
+	"github.com/video-platform/shared/pkg/storage/s3"
+)
+
+type MockS3Client struct {
+	mock.Mock
+}
+
+func (m *MockS3Client) Upload(ctx context.Context, bucket, key string, body io.Reader) error {
+	args := m.Called(ctx, bucket, key, body)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) Download(ctx context.Context, bucket, key string, writer io.WriterAt) error {
+	args := m.Called(ctx, bucket, key, writer)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockS3Client) Delete(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) DeleteMultiple(ctx context.Context, bucket string, keys []string) error {
+	args := m.Called(ctx, bucket, keys)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, size, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, expiration)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockS3Client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]s3.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]s3.ObjectInfo), args.Error(1)
+}
+
+func (m *MockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	args := m.Called(ctx, bucket, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	args := m.Called(ctx, bucket, key, uploadID, partNumber, data)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	args := m.Called(ctx, bucket, key, uploadID)
+	return args.Error(0)
+}
+
+func testFrame(t *testing.T, width, height int) io.ReadCloser {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return io.NopCloser(&buf)
+}
+
+func TestTransformFrameUseCase_Execute_ResizesAndCaches(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+
+	cmd := commands.TransformFrameCommand{
+		VideoID:  "video-123",
+		Filename: "frame_0001.jpg",
+		Width:    80,
+	}
+
+	cacheKey := "processed/video-123/derived/frame_0001_w80_h0_contain_q85.jpg"
+	mockS3.On("GetObject", ctx, "", cacheKey).Return(nil, errors.New("not found"))
+	mockS3.On("GetObject", ctx, "", "processed/video-123/frames/frame_0001.jpg").Return(testFrame(t, 160, 90), nil)
+	mockS3.On("Upload", ctx, "", cacheKey, mock.Anything).Return(nil)
+
+	useCase := NewTransformFrameUseCase(mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", result.ContentType)
+	assert.Equal(t, cacheKey, result.CacheKey)
+	assert.False(t, result.Cached)
+
+	img, err := jpeg.Decode(bytes.NewReader(result.Image))
+	assert.NoError(t, err)
+	assert.Equal(t, 80, img.Bounds().Dx())
+	assert.Equal(t, 45, img.Bounds().Dy())
+
+	mockS3.AssertExpectations(t)
+}
+
+func TestTransformFrameUseCase_Execute_CoverCropsToBox(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+
+	cmd := commands.TransformFrameCommand{
+		VideoID:  "video-123",
+		Filename: "frame_0001.jpg",
+		Width:    50,
+		Height:   50,
+		Fit:      FitCover,
+		Format:   FormatPNG,
+	}
+
+	cacheKey := "processed/video-123/derived/frame_0001_w50_h50_cover_q0.png"
+	mockS3.On("GetObject", ctx, "", cacheKey).Return(nil, errors.New("not found"))
+	mockS3.On("GetObject", ctx, "", "processed/video-123/frames/frame_0001.jpg").Return(testFrame(t, 160, 90), nil)
+	mockS3.On("Upload", ctx, "", cacheKey, mock.Anything).Return(nil)
+
+	useCase := NewTransformFrameUseCase(mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", result.ContentType)
+
+	img, _, err := image.Decode(bytes.NewReader(result.Image))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 50, 50), img.Bounds())
+}
+
+func TestTransformFrameUseCase_Execute_ServesCachedDerivative(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockS3 := new(MockS3Client)
+
+	cmd := commands.TransformFrameCommand{
+		VideoID:  "video-123",
+		Filename: "frame_0001.jpg",
+		Width:    80,
+		Height:   45,
+		Fit:      FitFill,
+		Quality:  70,
+	}
+
+	cacheKey := "processed/video-123/derived/frame_0001_w80_h45_fill_q70.jpg"
+	mockS3.On("GetObject", ctx, "", cacheKey).Return(io.NopCloser(bytes.NewReader([]byte("cached"))), nil)
+
+	useCase := NewTransformFrameUseCase(mockS3)
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.Cached)
+	assert.Equal(t, []byte("cached"), result.Image)
+
+	mockS3.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTransformFrameUseCase_Execute_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  commands.TransformFrameCommand
+	}{
+		{"path traversal", commands.TransformFrameCommand{VideoID: "..", Filename: "frame_0001.jpg"}},
+		{"foreign object", commands.TransformFrameCommand{VideoID: "video-123", Filename: "manifest.json"}},
+		{"oversized", commands.TransformFrameCommand{VideoID: "video-123", Filename: "frame_0001.jpg", Width: 10000}},
+		{"unknown fit", commands.TransformFrameCommand{VideoID: "video-123", Filename: "frame_0001.jpg", Fit: "zoom"}},
+		{"unknown format", commands.TransformFrameCommand{VideoID: "video-123", Filename: "frame_0001.jpg", Format: "gif"}},
+		{"bad quality", commands.TransformFrameCommand{VideoID: "video-123", Filename: "frame_0001.jpg", Quality: 101}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS3 := new(MockS3Client)
+			useCase := NewTransformFrameUseCase(mockS3)
+
+			result, err := useCase.Execute(context.Background(), tt.cmd)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			mockS3.AssertNotCalled(t, "GetObject", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -38,6 +39,57 @@ type Config struct {
 	APIGatewayURL     string
 	StorageServiceURL string
 
+	// StoragePublicURL is where clients reach the storage service's signed
+	// frame transform route.
+	StoragePublicURL string
+
+	// TransformSigningKey signs frame transform URLs
+	TransformSigningKey string
+
+	// APIPublicURL is the base URL clients use for the gateway's API routes,
+	// used for links that are followed without a bearer token.
+	APIPublicURL string
+
+	// PlaybackSigningKey signs HLS playlist URLs; the JWT secret is used
+	// when it is empty.
+	PlaybackSigningKey string
+
+	// AppVersion is the build a service reports about itself.
+	AppVersion string
+
+	// WorkerHeartbeat is how often a worker refreshes its entry in the Redis
+	// worker registry; entries expire after three missed beats.
+	WorkerHeartbeat time.Duration
+
+	// AdminUserIDs may use the gateway's admin routes. Admins are matched by
+	// ID because anyone can register an unclaimed username.
+	AdminUserIDs []int64
+
+	// Job dispatch on the processing queue. PremiumUserIDs get the premium
+	// tier's priority. Workers run at most UserJobLimit jobs of one user at
+	// once and hand the rest back to the queue after JobDeferDelay.
+	PremiumUserIDs []int64
+	UserJobLimit   int
+	JobDeferDelay  time.Duration
+
+	// Source limits the worker enforces after probing an upload. A zero
+	// duration or dimension disables that limit and an empty codec list
+	// accepts any codec.
+	MaxVideoDuration   time.Duration
+	MaxVideoDimension  int
+	AllowedVideoCodecs []string
+
+	// Worker resource guards. An empty ScratchDir uses the system temp dir.
+	// A job's deadline is JobTimeoutBase plus JobTimeoutFactor times the
+	// source duration; a zero base, memory or CPU time disables that guard.
+	ScratchDir        string
+	ScratchMinFreeMB  int
+	JobTimeoutBase    time.Duration
+	JobTimeoutFactor  float64
+	FFmpegNice        int
+	FFmpegMaxMemoryMB int
+	FFmpegMaxCPUTime  time.Duration
+
 	// SMTP
 	SMTPHost     string
 	SMTPPort     int
@@ -76,6 +128,74 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid HTTP_CLIENT_RETRY_COUNT: %w", err)
 	}
 
+	maxVideoDuration, err := time.ParseDuration(getEnv("MAX_VIDEO_DURATION", "4h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAX_VIDEO_DURATION: %w", err)
+	}
+
+	maxVideoDimension, err := strconv.Atoi(getEnv("MAX_VIDEO_DIMENSION", "4096"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAX_VIDEO_DIMENSION: %w", err)
+	}
+
+	scratchMinFree, err := strconv.Atoi(getEnv("SCRATCH_MIN_FREE_MB", "512"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCRATCH_MIN_FREE_MB: %w", err)
+	}
+
+	jobTimeoutBase, err := time.ParseDuration(getEnv("JOB_TIMEOUT_BASE", "10m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JOB_TIMEOUT_BASE: %w", err)
+	}
+
+	jobTimeoutFactor, err := strconv.ParseFloat(getEnv("JOB_TIMEOUT_FACTOR", "4"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JOB_TIMEOUT_FACTOR: %w", err)
+	}
+
+	ffmpegNice, err := strconv.Atoi(getEnv("FFMPEG_NICE", "10"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid FFMPEG_NICE: %w", err)
+	}
+
+	ffmpegMaxMemory, err := strconv.Atoi(getEnv("FFMPEG_MAX_MEMORY_MB", "8192"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid FFMPEG_MAX_MEMORY_MB: %w", err)
+	}
+
+	ffmpegMaxCPUTime, err := time.ParseDuration(getEnv("FFMPEG_MAX_CPU_TIME", "0s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid FFMPEG_MAX_CPU_TIME: %w", err)
+	}
+
+	workerHeartbeat, err := time.ParseDuration(getEnv("WORKER_HEARTBEAT_INTERVAL", "10s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid WORKER_HEARTBEAT_INTERVAL: %w", err)
+	}
+	if workerHeartbeat <= 0 {
+		return nil, fmt.Errorf("invalid WORKER_HEARTBEAT_INTERVAL: must be positive, got %s", workerHeartbeat)
+	}
+
+	userJobLimit, err := strconv.Atoi(getEnv("USER_JOB_LIMIT", "2"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid USER_JOB_LIMIT: %w", err)
+	}
+
+	jobDeferDelay, err := time.ParseDuration(getEnv("JOB_DEFER_DELAY", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JOB_DEFER_DELAY: %w", err)
+	}
+
+	adminUserIDs, err := getEnvIDList("ADMIN_USER_IDS")
+	if err != nil {
+		return nil, err
+	}
+
+	premiumUserIDs, err := getEnvIDList("PREMIUM_USER_IDS")
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
 		ServerPort:           getEnv("SERVER_PORT", "8080"),
 		DatabaseURL:          getEnv("DATABASE_URL", ""),
@@ -92,6 +212,26 @@ func Load() (*Config, error) {
 		AuthServiceURL:       getEnv("AUTH_SERVICE_URL", "http://auth-service:8080"),
 		APIGatewayURL:        getEnv("API_GATEWAY_URL", "http://api-gateway:8080"),
 		StorageServiceURL:    getEnv("STORAGE_SERVICE_URL", "http://storage-service:8080"),
+		StoragePublicURL:     getEnv("STORAGE_PUBLIC_URL", "http://localhost:8082"),
+		TransformSigningKey:  getEnv("TRANSFORM_SIGNING_KEY", ""),
+		APIPublicURL:         getEnv("API_PUBLIC_URL", "http://localhost:8080/api/v1"),
+		PlaybackSigningKey:   getEnv("PLAYBACK_SIGNING_KEY", ""),
+		AppVersion:           getEnv("APP_VERSION", "dev"),
+		WorkerHeartbeat:      workerHeartbeat,
+		AdminUserIDs:         adminUserIDs,
+		PremiumUserIDs:       premiumUserIDs,
+		UserJobLimit:         userJobLimit,
+		JobDeferDelay:        jobDeferDelay,
+		MaxVideoDuration:     maxVideoDuration,
+		MaxVideoDimension:    maxVideoDimension,
+		ScratchDir:           getEnv("SCRATCH_DIR", ""),
+		ScratchMinFreeMB:     scratchMinFree,
+		JobTimeoutBase:       jobTimeoutBase,
+		JobTimeoutFactor:     jobTimeoutFactor,
+		FFmpegNice:           ffmpegNice,
+		FFmpegMaxMemoryMB:    ffmpegMaxMemory,
+		FFmpegMaxCPUTime:     ffmpegMaxCPUTime,
+		AllowedVideoCodecs:   getEnvList("ALLOWED_VIDEO_CODECS", "h264,hevc,vp8,vp9,av1,mpeg4,mpeg2video,mjpeg,prores,msmpeg4v3,dvvideo"),
 		SMTPHost:             getEnv("SMTP_HOST", ""),
 		SMTPPort:             smtpPort,
 		SMTPUser:             getEnv("SMTP_USER", ""),
@@ -108,3 +248,28 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvIDList parses a comma-separated environment variable of user IDs
+func getEnvIDList(key string) ([]int64, error) {
+	var ids []int64
+	for _, item := range getEnvList(key, "") {
+		id, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// getEnvList splits a comma-separated environment variable, or its default,
+// into its non-empty trimmed items
+func getEnvList(key, defaultValue string) []string {
+	var items []string
+	for _, item := range strings.Split(getEnv(key, defaultValue), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
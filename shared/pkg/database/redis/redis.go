@@ -8,6 +8,9 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// Nil is the error Get returns for a missing key
+var Nil = redis.Nil
+
 // Client wraps redis client
 type Client struct {
 	client *redis.Client
@@ -38,6 +41,12 @@ func (c *Client) Set(ctx context.Context, key string, value interface{}, expirat
 	return c.client.Set(ctx, key, value, expiration).Err()
 }
 
+// SetNX sets a key-value pair with expiration unless the key exists, and
+// reports whether it was set
+func (c *Client) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, key, value, expiration).Result()
+}
+
 // Get gets a value by key
 func (c *Client) Get(ctx context.Context, key string) (string, error) {
 	return c.client.Get(ctx, key).Result()
@@ -63,6 +72,17 @@ func (c *Client) Expire(ctx context.Context, key string, expiration time.Duratio
 	return c.client.Expire(ctx, key, expiration).Err()
 }
 
+// ScanKeys returns the keys matching a glob pattern. It iterates with SCAN,
+// so it does not block the server the way KEYS does.
+func (c *Client) ScanKeys(ctx context.Context, pattern string) ([]string, error) {
+	var keys []string
+	iter := c.client.Scan(ctx, 0, pattern, 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	return keys, iter.Err()
+}
+
 // Close closes the Redis connection
 func (c *Client) Close() error {
 	return c.client.Close()
@@ -4,13 +4,35 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// MaxPriority is the highest priority a message on a priority queue can
+// carry.
+const MaxPriority = 9
+
+// priorityQueues are declared with x-max-priority, so higher priority
+// messages are delivered first. A queue's arguments cannot change once it
+// exists, so publishers and consumers must declare it the same way, and an
+// existing queue cannot become a priority queue; use a new name instead.
+var priorityQueues = map[string]bool{
+	"video.processing.priority": true,
+}
+
+// PublishOptions control how a message is delivered. A zero Delay delivers
+// it right away; otherwise it waits in the queue's deferred queue, which
+// dead-letters it back once the delay expires.
+type PublishOptions struct {
+	Priority uint8
+	Delay    time.Duration
+}
+
 type Publisher interface {
 	Publish(ctx context.Context, queueName string, message interface{}) error
+	PublishWithOptions(ctx context.Context, queueName string, message interface{}, opts PublishOptions) error
 	Close() error
 }
 
@@ -44,17 +66,32 @@ func NewPublisher(url string) (Publisher, error) {
 }
 
 func (p *publisher) Publish(ctx context.Context, queueName string, message interface{}) error {
+	return p.PublishWithOptions(ctx, queueName, message, PublishOptions{})
+}
+
+func (p *publisher) PublishWithOptions(ctx context.Context, queueName string, message interface{}, opts PublishOptions) error {
 	// Declare queue (idempotent)
-	_, err := p.channel.QueueDeclare(
-		queueName,
-		true,  // durable
-		false, // auto-delete
-		false, // exclusive
-		false, // no-wait
-		nil,   // arguments
-	)
-	if err != nil {
-		return fmt.Errorf("failed to declare queue: %w", err)
+	if err := declareQueue(p.channel, queueName); err != nil {
+		return err
+	}
+
+	routingKey, expiration := queueName, ""
+	if opts.Delay > 0 {
+		routingKey = deferredQueue(queueName)
+		if _, err := p.channel.QueueDeclare(
+			routingKey,
+			true,  // durable
+			false, // auto-delete
+			false, // exclusive
+			false, // no-wait
+			amqp.Table{
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": queueName,
+			},
+		); err != nil {
+			return fmt.Errorf("failed to declare deferred queue: %w", err)
+		}
+		expiration = strconv.FormatInt(max(opts.Delay.Milliseconds(), 1), 10)
 	}
 
 	// Marshal message to JSON
@@ -66,15 +103,17 @@ func (p *publisher) Publish(ctx context.Context, queueName string, message inter
 	// Publish message
 	err = p.channel.PublishWithContext(
 		ctx,
-		"",        // exchange
-		queueName, // routing key
-		false,     // mandatory
-		false,     // immediate
+		"",         // exchange
+		routingKey, // routing key
+		false,      // mandatory
+		false,      // immediate
 		amqp.Publishing{
 			DeliveryMode: amqp.Persistent,
 			ContentType:  "application/json",
 			Body:         body,
 			Timestamp:    time.Now(),
+			Priority:     min(opts.Priority, MaxPriority),
+			Expiration:   expiration,
 		},
 	)
 	if err != nil {
@@ -125,16 +164,8 @@ func NewConsumer(url string) (*Consumer, error) {
 // Consume consumes messages from a queue
 func (c *Consumer) Consume(ctx context.Context, queueName string, handler func([]byte) error) error {
 	// Declare queue (idempotent)
-	_, err := c.channel.QueueDeclare(
-		queueName,
-		true,  // durable
-		false, // auto-delete
-		false, // exclusive
-		false, // no-wait
-		nil,   // arguments
-	)
-	if err != nil {
-		return fmt.Errorf("failed to declare queue: %w", err)
+	if err := declareQueue(c.channel, queueName); err != nil {
+		return err
 	}
 
 	// Start consuming
@@ -173,6 +204,31 @@ func (c *Consumer) Consume(ctx context.Context, queueName string, handler func([
 	}
 }
 
+func declareQueue(channel *amqp.Channel, queueName string) error {
+	var args amqp.Table
+	if priorityQueues[queueName] {
+		args = amqp.Table{"x-max-priority": MaxPriority}
+	}
+
+	_, err := channel.QueueDeclare(
+		queueName,
+		true,  // durable
+		false, // auto-delete
+		false, // exclusive
+		false, // no-wait
+		args,  // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare queue: %w", err)
+	}
+	return nil
+}
+
+// deferredQueue holds delayed messages for a queue until they expire.
+func deferredQueue(queueName string) string {
+	return queueName + ".deferred"
+}
+
 // Close closes the consumer connection
 func (c *Consumer) Close() error {
 	if err := c.channel.Close(); err != nil {
@@ -1,9 +1,11 @@
 package s3
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -21,7 +23,32 @@ type S3Client interface {
 	Delete(ctx context.Context, bucket, key string) error
 	DeleteMultiple(ctx context.Context, bucket string, keys []string) error
 	GeneratePresignedURL(ctx context.Context, bucket, key string, expiration time.Duration) (string, error)
+	// GeneratePresignedPutURL signs an upload of exactly size bytes.
+	GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error)
+	// GeneratePresignedPartURL signs the upload of one part of a multipart
+	// upload.
+	GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error)
 	ListObjects(ctx context.Context, bucket, prefix string) ([]string, error)
+	ListObjectDetails(ctx context.Context, bucket, prefix string) ([]ObjectInfo, error)
+
+	// Multipart uploads let a client send an object in parts over several
+	// requests. Every part but the last must be at least MinPartSize.
+	CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error)
+	UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error
+	CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error
+	AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error
+}
+
+// MinPartSize is the smallest part S3 accepts in a multipart upload, other
+// than the last one.
+const MinPartSize = 5 << 20
+
+// ObjectInfo describes a stored object. ETag is unquoted; for objects
+// uploaded in a single part it is the hex MD5 of the content.
+type ObjectInfo struct {
+	Key  string
+	Size int64
+	ETag string
 }
 
 type client struct {
@@ -136,6 +163,41 @@ func (c *client) GeneratePresignedURL(ctx context.Context, bucket, key string, e
 	return presignedReq.URL, nil
 }
 
+func (c *client) GeneratePresignedPutURL(ctx context.Context, bucket, key string, size int64, expiration time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.s3Client)
+
+	presignedReq, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(key),
+		ContentLength: aws.Int64(size),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expiration
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned upload URL: %w", err)
+	}
+
+	return presignedReq.URL, nil
+}
+
+func (c *client) GeneratePresignedPartURL(ctx context.Context, bucket, key, uploadID string, partNumber int32, expiration time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.s3Client)
+
+	presignedReq, err := presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(bucket),
+		Key:        aws.String(key),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int32(partNumber),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expiration
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned part URL %d: %w", partNumber, err)
+	}
+
+	return presignedReq.URL, nil
+}
+
 func (c *client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
 	result, err := c.s3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
 		Bucket: aws.String(bucket),
@@ -152,3 +214,105 @@ func (c *client) ListObjects(ctx context.Context, bucket, prefix string) ([]stri
 
 	return keys, nil
 }
+
+// ListObjectDetails lists every object under prefix, following pagination.
+func (c *client) ListObjectDetails(ctx context.Context, bucket, prefix string) ([]ObjectInfo, error) {
+	paginator := s3.NewListObjectsV2Paginator(c.s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	var objects []ObjectInfo
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			objects = append(objects, ObjectInfo{
+				Key:  aws.ToString(obj.Key),
+				Size: aws.ToInt64(obj.Size),
+				ETag: strings.Trim(aws.ToString(obj.ETag), `"`),
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// CreateMultipartUpload starts a multipart upload and returns its ID.
+func (c *client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	result, err := c.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+	return aws.ToString(result.UploadId), nil
+}
+
+func (c *client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte) error {
+	_, err := c.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(key),
+		UploadId:      aws.String(uploadID),
+		PartNumber:    aws.Int32(partNumber),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+	}
+	return nil
+}
+
+// CompleteMultipartUpload assembles the uploaded parts into the object. The
+// parts are listed from S3, so callers need not keep their ETags.
+func (c *client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	paginator := s3.NewListPartsPaginator(c.s3Client, &s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+
+	var parts []s3Types.CompletedPart
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list parts: %w", err)
+		}
+
+		for _, part := range page.Parts {
+			parts = append(parts, s3Types.CompletedPart{
+				ETag:       part.ETag,
+				PartNumber: part.PartNumber,
+			})
+		}
+	}
+
+	_, err := c.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3Types.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	return nil
+}
+
+// AbortMultipartUpload discards a multipart upload and its parts.
+func (c *client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	_, err := c.s3Client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	return nil
+}
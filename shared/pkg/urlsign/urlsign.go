@@ -0,0 +1,85 @@
+package urlsign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	expiresParam   = "expires"
+	signatureParam = "sig"
+)
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpired          = errors.New("signature expired")
+)
+
+// FramePath is the storage service route of a frame transform. Both sides
+// sign this path rather than the request path, which a proxy in front of
+// the storage service may rewrite.
+func FramePath(videoID, filename string) string {
+	return "/frames/" + videoID + "/" + filename
+}
+
+// Sign adds an expiry and an HMAC-SHA256 signature covering the path and
+// every query parameter to query, and returns the encoded query string.
+func Sign(secret, path string, query url.Values, expires time.Time) string {
+	signed := url.Values{}
+	for key, values := range query {
+		signed[key] = values
+	}
+	signed.Set(expiresParam, strconv.FormatInt(expires.Unix(), 10))
+	signed.Set(signatureParam, signature(secret, path, signed))
+	return signed.Encode()
+}
+
+// Verify checks that query carries a valid, unexpired signature for path.
+func Verify(secret, path string, query url.Values, now time.Time) error {
+	sig := query.Get(signatureParam)
+	if sig == "" {
+		return ErrMissingSignature
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(signature(secret, path, query))) {
+		return ErrInvalidSignature
+	}
+
+	expires, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if err != nil || now.Unix() > expires {
+		return ErrExpired
+	}
+
+	return nil
+}
+
+// Expires returns the expiry carried by a signed query. It does not check
+// the signature; call Verify first.
+func Expires(query url.Values) (time.Time, error) {
+	expires, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if err != nil {
+		return time.Time{}, ErrExpired
+	}
+	return time.Unix(expires, 0), nil
+}
+
+// signature signs the path and the query without its signature; Encode
+// sorts the keys, so parameter order does not matter.
+func signature(secret, path string, query url.Values) string {
+	unsigned := url.Values{}
+	for key, values := range query {
+		if key != signatureParam {
+			unsigned[key] = values
+		}
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(path + "?" + unsigned.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}